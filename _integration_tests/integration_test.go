@@ -48,6 +48,16 @@ type CreateGreetingPayload struct {
 	}
 }
 
+func TestValidation(t *testing.T) {
+	defer os.RemoveAll("./validation/app")
+	if err := goagen("./validation", "app", "-d", "github.com/goadesign/goa/_integration_tests/validation/design"); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := gotest("./validation"); err != nil {
+		t.Error(err.Error())
+	}
+}
+
 func TestCellar(t *testing.T) {
 	if err := os.MkdirAll("./goa-cellar", 0755); err != nil {
 		t.Error(err.Error())
@@ -92,3 +102,13 @@ func gobuild(dir string) error {
 	}
 	return nil
 }
+
+func gotest(dir string) error {
+	cmd := exec.Command("go", "test", ".")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s\n%s", err.Error(), out)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package design
+
+import (
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var _ = API("validation", func() {
+	Title("An API exercising the generated validation code")
+	Host("localhost:8080")
+	Scheme("http")
+})
+
+// Item is an order line identified by its SKU.
+var Item = Type("item", func() {
+	Attribute("sku", String, "Stock keeping unit.")
+	Attribute("quantity", Integer, "Number of units.")
+	Required("sku")
+})
+
+var _ = Resource("measure", func() {
+	Action("create", func() {
+		Routing(
+			POST("/"))
+		Payload(func() {
+			Attribute("ratio", Number, func() {
+				Description("A number strictly between 0 and 1.")
+				ExclusiveMinimum(0)
+				ExclusiveMaximum(1)
+			})
+			Attribute("count", Integer, func() {
+				Description("An integer strictly less than 10.")
+				ExclusiveMaximum(10)
+			})
+			Attribute("code", String, func() {
+				Description("A string between 2 and 3 bytes long.")
+				MinLength(2)
+				MaxLength(3)
+				Metadata("length:unit", "bytes")
+			})
+			Attribute("label", String, func() {
+				Description("A string between 2 and 3 characters long.")
+				MinLength(2)
+				MaxLength(3)
+				Metadata("length:unit", "runes")
+			})
+			Required("ratio", "count", "code", "label")
+		})
+		Response(NoContent)
+		Response(BadRequest)
+	})
+
+	Action("contact", func() {
+		Routing(
+			POST("/contact"))
+		Payload(func() {
+			Attribute("email", String, "Email address, exclusive with phone.")
+			Attribute("phone", String, "Phone number, exclusive with email.")
+			ExactlyOneOf("email", "phone")
+		})
+		Response(NoContent)
+		Response(BadRequest)
+	})
+
+	Action("tag", func() {
+		Routing(
+			POST("/tags"))
+		Payload(func() {
+			Attribute("tags", ArrayOf(String), "Distinct tags.", func() {
+				UniqueItems()
+			})
+			Required("tags")
+		})
+		Response(NoContent)
+		Response(BadRequest)
+	})
+
+	Action("order", func() {
+		Routing(
+			POST("/orders"))
+		Payload(func() {
+			Attribute("items", ArrayOf(Item), "Order lines with distinct SKUs.", func() {
+				UniqueKey("sku")
+			})
+			Required("items")
+		})
+		Response(NoContent)
+		Response(BadRequest)
+	})
+
+	Action("notify", func() {
+		Routing(
+			POST("/notify"))
+		Payload(func() {
+			Attribute("channel", String, "Notification channel.", func() {
+				Enum("email", "sms")
+			})
+			Attribute("email", String, "Email address.")
+			Attribute("phone", String, "Phone number, required for the sms channel.")
+			RequiredIf("channel", "sms", "phone")
+		})
+		Response(NoContent)
+		Response(BadRequest)
+	})
+})
@@ -0,0 +1,122 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/goadesign/goa/_integration_tests/validation/app"
+)
+
+// valid returns a payload that satisfies all the validations.
+func valid() *app.CreateMeasurePayload {
+	return &app.CreateMeasurePayload{Ratio: 0.5, Count: 9, Code: "ab", Label: "ab"}
+}
+
+func TestExclusiveBounds(t *testing.T) {
+	if err := valid().Validate(); err != nil {
+		t.Errorf("interior values: unexpected error %s", err)
+	}
+	cases := map[string]func(p *app.CreateMeasurePayload){
+		"ratio equal to exclusive minimum": func(p *app.CreateMeasurePayload) { p.Ratio = 0 },
+		"ratio equal to exclusive maximum": func(p *app.CreateMeasurePayload) { p.Ratio = 1 },
+		"count equal to exclusive maximum": func(p *app.CreateMeasurePayload) { p.Count = 10 },
+	}
+	for name, mutate := range cases {
+		p := valid()
+		mutate(p)
+		if err := p.Validate(); err == nil {
+			t.Errorf("%s: expected a validation error", name)
+		}
+	}
+}
+
+func TestLengthUnit(t *testing.T) {
+	// "é" is one rune encoded with two bytes.
+	cases := []struct {
+		name   string
+		mutate func(p *app.CreateMeasurePayload)
+		valid  bool
+	}{
+		{"bytes: 1 rune, 2 bytes", func(p *app.CreateMeasurePayload) { p.Code = "é" }, true},
+		{"bytes: 2 runes, 4 bytes", func(p *app.CreateMeasurePayload) { p.Code = "éé" }, false},
+		{"runes: 1 rune, 2 bytes", func(p *app.CreateMeasurePayload) { p.Label = "é" }, false},
+		{"runes: 3 runes, 6 bytes", func(p *app.CreateMeasurePayload) { p.Label = "ééé" }, true},
+	}
+	for _, c := range cases {
+		p := valid()
+		c.mutate(p)
+		err := p.Validate()
+		if c.valid && err != nil {
+			t.Errorf("%s: unexpected error %s", c.name, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("%s: expected a validation error", c.name)
+		}
+	}
+}
+
+func TestExactlyOneOf(t *testing.T) {
+	email, phone := "me@example.com", "555-0100"
+	cases := []struct {
+		name  string
+		p     *app.ContactMeasurePayload
+		valid bool
+	}{
+		{"neither set", &app.ContactMeasurePayload{}, false},
+		{"email only", &app.ContactMeasurePayload{Email: &email}, true},
+		{"phone only", &app.ContactMeasurePayload{Phone: &phone}, true},
+		{"both set", &app.ContactMeasurePayload{Email: &email, Phone: &phone}, false},
+	}
+	for _, c := range cases {
+		err := c.p.Validate()
+		if c.valid && err != nil {
+			t.Errorf("%s: unexpected error %s", c.name, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("%s: expected a validation error", c.name)
+		}
+	}
+}
+
+func TestUniqueItems(t *testing.T) {
+	if err := (&app.TagMeasurePayload{Tags: []string{"a", "b"}}).Validate(); err != nil {
+		t.Errorf("distinct tags: unexpected error %s", err)
+	}
+	if err := (&app.TagMeasurePayload{Tags: []string{"a", "b", "a"}}).Validate(); err == nil {
+		t.Errorf("duplicate tags: expected a validation error")
+	}
+}
+
+func TestUniqueKey(t *testing.T) {
+	one, two := 1, 2
+	distinct := &app.OrderMeasurePayload{Items: []*app.Item{{Sku: "a", Quantity: &one}, {Sku: "b", Quantity: &one}}}
+	if err := distinct.Validate(); err != nil {
+		t.Errorf("distinct SKUs: unexpected error %s", err)
+	}
+	shared := &app.OrderMeasurePayload{Items: []*app.Item{{Sku: "a", Quantity: &one}, {Sku: "a", Quantity: &two}}}
+	if err := shared.Validate(); err == nil {
+		t.Errorf("shared SKU: expected a validation error")
+	}
+}
+
+func TestRequiredIf(t *testing.T) {
+	sms, email, phone := "sms", "email", "555-0100"
+	cases := []struct {
+		name  string
+		p     *app.NotifyMeasurePayload
+		valid bool
+	}{
+		{"no channel", &app.NotifyMeasurePayload{}, true},
+		{"email without phone", &app.NotifyMeasurePayload{Channel: &email}, true},
+		{"sms with phone", &app.NotifyMeasurePayload{Channel: &sms, Phone: &phone}, true},
+		{"sms without phone", &app.NotifyMeasurePayload{Channel: &sms}, false},
+	}
+	for _, c := range cases {
+		err := c.p.Validate()
+		if c.valid && err != nil {
+			t.Errorf("%s: unexpected error %s", c.name, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("%s: expected a validation error", c.name)
+		}
+	}
+}
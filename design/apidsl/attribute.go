@@ -2,6 +2,7 @@ package apidsl
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -476,31 +477,7 @@ func Pattern(p string) {
 // Minimum adds a "minimum" validation to the attribute.
 // See http://json-schema.org/latest/json-schema-validation.html#anchor21.
 func Minimum(val interface{}) {
-	if a, ok := attributeDefinition(); ok {
-		if a.Type != nil && a.Type.Kind() != design.IntegerKind && a.Type.Kind() != design.NumberKind {
-			incompatibleAttributeType("minimum", a.Type.Name(), "an integer or a number")
-		} else {
-			var f float64
-			switch v := val.(type) {
-			case float32, float64, int, int8, int16, int32, int64, uint8, uint16, uint32, uint64:
-				f = reflect.ValueOf(v).Convert(reflect.TypeOf(float64(0.0))).Float()
-			case string:
-				var err error
-				f, err = strconv.ParseFloat(v, 64)
-				if err != nil {
-					dslengine.ReportError("invalid number value %#v", v)
-					return
-				}
-			default:
-				dslengine.ReportError("invalid number value %#v", v)
-				return
-			}
-			if a.Validation == nil {
-				a.Validation = &dslengine.ValidationDefinition{}
-			}
-			a.Validation.Minimum = &f
-		}
-	}
+	setBound("minimum", val, true, false)
 }
 
 // Maximum can be used in: Attribute, Header, Param, HashOf, ArrayOf
@@ -508,30 +485,71 @@ func Minimum(val interface{}) {
 // Maximum adds a "maximum" validation to the attribute.
 // See http://json-schema.org/latest/json-schema-validation.html#anchor17.
 func Maximum(val interface{}) {
-	if a, ok := attributeDefinition(); ok {
-		if a.Type != nil && a.Type.Kind() != design.IntegerKind && a.Type.Kind() != design.NumberKind {
-			incompatibleAttributeType("maximum", a.Type.Name(), "an integer or a number")
-		} else {
-			var f float64
-			switch v := val.(type) {
-			case float32, float64, int, int8, int16, int32, int64, uint8, uint16, uint32, uint64:
-				f = reflect.ValueOf(v).Convert(reflect.TypeOf(float64(0.0))).Float()
-			case string:
-				var err error
-				f, err = strconv.ParseFloat(v, 64)
-				if err != nil {
-					dslengine.ReportError("invalid number value %#v", v)
-					return
-				}
-			default:
-				dslengine.ReportError("invalid number value %#v", v)
-				return
-			}
-			if a.Validation == nil {
-				a.Validation = &dslengine.ValidationDefinition{}
-			}
-			a.Validation.Maximum = &f
+	setBound("maximum", val, false, false)
+}
+
+// ExclusiveMinimum can be used in: Attribute, Header, Param, HashOf, ArrayOf
+//
+// ExclusiveMinimum adds a "minimum" validation that excludes the given value to the attribute,
+// i.e. the attribute value must be strictly greater than val.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor21.
+func ExclusiveMinimum(val interface{}) {
+	setBound("exclusive minimum", val, true, true)
+}
+
+// ExclusiveMaximum can be used in: Attribute, Header, Param, HashOf, ArrayOf
+//
+// ExclusiveMaximum adds a "maximum" validation that excludes the given value to the attribute,
+// i.e. the attribute value must be strictly less than val.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor17.
+func ExclusiveMaximum(val interface{}) {
+	setBound("exclusive maximum", val, false, true)
+}
+
+// setBound sets the minimum (if min is true) or maximum value validation of the current
+// attribute together with whether the bound is exclusive. validation is the name of the
+// validation used in error messages.
+func setBound(validation string, val interface{}, min, exclusive bool) {
+	a, ok := attributeDefinition()
+	if !ok {
+		return
+	}
+	if a.Type != nil && a.Type.Kind() != design.IntegerKind && a.Type.Kind() != design.NumberKind {
+		incompatibleAttributeType(validation, a.Type.Name(), "an integer or a number")
+		return
+	}
+	var f float64
+	switch v := val.(type) {
+	case float32, float64, int, int8, int16, int32, int64, uint8, uint16, uint32, uint64:
+		f = reflect.ValueOf(v).Convert(reflect.TypeOf(float64(0.0))).Float()
+	case string:
+		var err error
+		f, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			dslengine.ReportError("invalid number value %#v", v)
+			return
 		}
+	default:
+		dslengine.ReportError("invalid number value %#v", v)
+		return
+	}
+	if a.Type != nil && a.Type.Kind() == design.IntegerKind && f != math.Trunc(f) {
+		dslengine.ReportError("invalid %s value %v, value must be an integer", validation, f)
+		return
+	}
+	if a.Validation == nil {
+		a.Validation = &dslengine.ValidationDefinition{}
+	}
+	if min {
+		a.Validation.Minimum = &f
+		a.Validation.ExclusiveMinimum = exclusive
+	} else {
+		a.Validation.Maximum = &f
+		a.Validation.ExclusiveMaximum = exclusive
+	}
+	if a.HasEmptyRange() {
+		dslengine.ReportError("no value satisfies both the minimum %v and the maximum %v",
+			*a.Validation.Minimum, *a.Validation.Maximum)
 	}
 }
 
@@ -569,6 +587,46 @@ func MaxLength(val int) {
 	}
 }
 
+// UniqueItems can be used in: Attribute, Header, Param, ArrayOf
+//
+// UniqueItems adds a "uniqueItems" validation to the attribute. The elements of the array must be
+// of type Boolean, Integer, Number, String or UUID.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor49.
+func UniqueItems() {
+	if a, ok := attributeDefinition(); ok {
+		if a.Type != nil && a.Type.Kind() != design.ArrayKind {
+			incompatibleAttributeType("unique items", a.Type.Name(), "an array")
+		} else {
+			if a.Validation == nil {
+				a.Validation = &dslengine.ValidationDefinition{}
+			}
+			a.Validation.UniqueItems = true
+		}
+	}
+}
+
+// UniqueKey can be used in: Attribute, ArrayOf
+//
+// UniqueKey adds a validation that requires the elements of an array of objects to have distinct
+// values for the attribute with the given name. The attribute must be of type Boolean, Integer,
+// Number, String or UUID.
+//
+//	Attribute("items", ArrayOf(Item), func() {
+//		UniqueKey("sku")
+//	})
+func UniqueKey(name string) {
+	if a, ok := attributeDefinition(); ok {
+		if a.Type != nil && a.Type.Kind() != design.ArrayKind {
+			incompatibleAttributeType("unique key", a.Type.Name(), "an array")
+		} else {
+			if a.Validation == nil {
+				a.Validation = &dslengine.ValidationDefinition{}
+			}
+			a.Validation.UniqueKey = name
+		}
+	}
+}
+
 // Required can be used in: Attributes, Headers, Payload, Type, Params
 //
 // Required adds a "required" validation to the attribute.
@@ -596,6 +654,94 @@ func Required(names ...string) {
 	}
 }
 
+// ExactlyOneOf can be used in: Attributes, Payload, Type
+//
+// ExactlyOneOf adds a validation that requires exactly one of the attributes with the given names
+// to be set. Each call defines a separate group of mutually exclusive attributes. The attributes
+// may not be required or have a default value. Params and headers are validated one by one so
+// they may not define exactly one of validations.
+//
+//	Payload(func() {
+//		Attribute("email", String)
+//		Attribute("phone", String)
+//		ExactlyOneOf("email", "phone")
+//	})
+func ExactlyOneOf(names ...string) {
+	var at *design.AttributeDefinition
+
+	switch def := dslengine.CurrentDefinition().(type) {
+	case *design.AttributeDefinition:
+		at = def
+	case *design.MediaTypeDefinition:
+		at = def.AttributeDefinition
+	default:
+		dslengine.IncompatibleDSL()
+		return
+	}
+
+	if len(names) < 2 {
+		dslengine.ReportError("exactly one of validation requires at least two attribute names")
+		return
+	}
+	if at.Type != nil && at.Type.Kind() != design.ObjectKind {
+		incompatibleAttributeType("exactly one of", at.Type.Name(), "an object")
+	} else {
+		if at.Validation == nil {
+			at.Validation = &dslengine.ValidationDefinition{}
+		}
+		at.Validation.AddExactlyOneOf(names)
+	}
+}
+
+// RequiredIf can be used in: Attributes, Payload, Type
+//
+// RequiredIf adds a validation that requires the attributes with the given names to be set only
+// when the attribute named attribute is set to value. The attribute must be of type boolean,
+// integer, number or string. The required attributes may not be required or have a default
+// value. Params and headers are validated one by one so they may not define required if
+// validations.
+//
+//	Payload(func() {
+//		Attribute("channel", String, func() {
+//			Enum("email", "sms")
+//		})
+//		Attribute("email", String)
+//		Attribute("phone", String)
+//		RequiredIf("channel", "sms", "phone")
+//	})
+func RequiredIf(attribute string, value interface{}, names ...string) {
+	var at *design.AttributeDefinition
+
+	switch def := dslengine.CurrentDefinition().(type) {
+	case *design.AttributeDefinition:
+		at = def
+	case *design.MediaTypeDefinition:
+		at = def.AttributeDefinition
+	default:
+		dslengine.IncompatibleDSL()
+		return
+	}
+
+	switch value.(type) {
+	case bool, string, float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+	default:
+		dslengine.ReportError("invalid required if value %#v, value must be a boolean, a number or a string", value)
+		return
+	}
+	if len(names) == 0 {
+		dslengine.ReportError("required if validation requires at least one attribute name")
+		return
+	}
+	if at.Type != nil && at.Type.Kind() != design.ObjectKind {
+		incompatibleAttributeType("required if", at.Type.Name(), "an object")
+	} else {
+		if at.Validation == nil {
+			at.Validation = &dslengine.ValidationDefinition{}
+		}
+		at.Validation.AddRequiredIf(attribute, value, names)
+	}
+}
+
 // incompatibleAttributeType reports an error for validations defined on
 // incompatible attributes (e.g. max value on string).
 func incompatibleAttributeType(validation, actual, expected string) {
@@ -271,6 +271,140 @@ var _ = Describe("Attribute", func() {
 		})
 	})
 
+	Context("with a name, type number and a DSL defining exclusive min and max validations", func() {
+		BeforeEach(func() {
+			name = "foo"
+			dataType = Number
+			dsl = func() {
+				ExclusiveMinimum(0)
+				ExclusiveMaximum(1)
+			}
+		})
+
+		It("produces an attribute of type number with exclusive min and max validations", func() {
+			o := parent.Type.(Object)
+			Ω(o).Should(HaveKey(name))
+			Ω(o[name].Validation).ShouldNot(BeNil())
+			Ω(*o[name].Validation.Minimum).Should(Equal(0.0))
+			Ω(o[name].Validation.ExclusiveMinimum).Should(BeTrue())
+			Ω(*o[name].Validation.Maximum).Should(Equal(1.0))
+			Ω(o[name].Validation.ExclusiveMaximum).Should(BeTrue())
+		})
+	})
+
+	Context("with a name, type number and a DSL defining an invalid exclusive min validation", func() {
+		BeforeEach(func() {
+			name = "foo"
+			dataType = Number
+			dsl = func() {
+				Minimum(1)
+				ExclusiveMinimum("foo")
+			}
+		})
+
+		It("reports an error and leaves the existing minimum inclusive", func() {
+			Ω(dslengine.Errors).Should(HaveOccurred())
+			o := parent.Type.(Object)
+			Ω(*o[name].Validation.Minimum).Should(Equal(1.0))
+			Ω(o[name].Validation.ExclusiveMinimum).Should(BeFalse())
+		})
+	})
+
+	Context("with a name, type integer and a DSL defining a non-integral exclusive min validation", func() {
+		BeforeEach(func() {
+			name = "foo"
+			dataType = Integer
+			dsl = func() { ExclusiveMinimum(-0.5) }
+		})
+
+		It("reports an error and does not set the minimum", func() {
+			Ω(dslengine.Errors).Should(HaveOccurred())
+			Ω(dslengine.Errors.Error()).Should(ContainSubstring("invalid exclusive minimum value -0.5, value must be an integer"))
+			o := parent.Type.(Object)
+			Ω(o[name].Validation).Should(BeNil())
+		})
+	})
+
+	Context("with a name, type string and a DSL defining an exclusive max validation", func() {
+		BeforeEach(func() {
+			name = "foo"
+			dataType = String
+			dsl = func() { ExclusiveMaximum(1) }
+		})
+
+		It("reports an incompatible exclusive maximum validation", func() {
+			Ω(dslengine.Errors).Should(HaveOccurred())
+			Ω(dslengine.Errors.Error()).Should(ContainSubstring("invalid exclusive maximum validation definition"))
+		})
+	})
+
+	Context("with a name and a DSL defining an exactly one of validation", func() {
+		BeforeEach(func() {
+			name = "foo"
+			dsl = func() {
+				Attribute("email")
+				Attribute("phone")
+				ExactlyOneOf("email", "phone")
+			}
+		})
+
+		It("produces an object attribute with an exactly one of validation", func() {
+			Ω(dslengine.Errors).ShouldNot(HaveOccurred())
+			o := parent.Type.(Object)
+			Ω(o[name].Validation).ShouldNot(BeNil())
+			Ω(o[name].Validation.ExactlyOneOf).Should(Equal([][]string{{"email", "phone"}}))
+		})
+	})
+
+	Context("with a name, type string and a DSL defining an exactly one of validation", func() {
+		BeforeEach(func() {
+			name = "foo"
+			dataType = String
+			dsl = func() { ExactlyOneOf("email", "phone") }
+		})
+
+		It("reports an incompatible exactly one of validation", func() {
+			Ω(dslengine.Errors).Should(HaveOccurred())
+			Ω(dslengine.Errors.Error()).Should(ContainSubstring("invalid exactly one of validation definition"))
+		})
+	})
+
+	Context("with a name and a DSL defining a required if validation", func() {
+		BeforeEach(func() {
+			name = "foo"
+			dsl = func() {
+				Attribute("channel")
+				Attribute("phone")
+				RequiredIf("channel", "sms", "phone")
+			}
+		})
+
+		It("produces an object attribute with a required if validation", func() {
+			Ω(dslengine.Errors).ShouldNot(HaveOccurred())
+			o := parent.Type.(Object)
+			Ω(o[name].Validation).ShouldNot(BeNil())
+			Ω(o[name].Validation.RequiredIf).Should(Equal([]*dslengine.ConditionalRequiredDefinition{
+				{Attribute: "channel", Value: "sms", Required: []string{"phone"}},
+			}))
+		})
+	})
+
+	Context("with a name and a DSL defining a required if validation with an invalid value", func() {
+		BeforeEach(func() {
+			name = "foo"
+			dsl = func() {
+				Attribute("channel")
+				Attribute("phone")
+				RequiredIf("channel", []string{"sms"}, "phone")
+			}
+		})
+
+		It("reports an invalid required if value", func() {
+			Ω(dslengine.Errors).Should(HaveOccurred())
+			Ω(dslengine.Errors.Error()).Should(ContainSubstring("invalid required if value"))
+		})
+	})
+
 	Context("with a name, type integer, a description and a DSL defining an enum validation", func() {
 		BeforeEach(func() {
 			name = "foo"
@@ -384,6 +384,19 @@ var _ = Describe("Example", func() {
 						Minimum(0)
 						Maximum(0)
 					})
+					Attribute("test6", Integer, func() {
+						ExclusiveMinimum(0)
+						ExclusiveMaximum(2)
+					})
+					Attribute("test7", Integer, func() {
+						ExclusiveMaximum(0)
+					})
+					Attribute("test8", Number, func() {
+						ExclusiveMaximum(0)
+					})
+					Attribute("test9", Number, func() {
+						ExclusiveMinimum(0)
+					})
 				})
 				View("default", func() {
 					Attribute("test1")
@@ -406,6 +419,14 @@ var _ = Describe("Example", func() {
 			Ω(attr.Example).ShouldNot(BeNil())
 			attr = mt.Type.ToObject()["test-failure1"]
 			Ω(attr.Example).Should(Equal(0))
+			attr = mt.Type.ToObject()["test6"]
+			Ω(attr.Example).Should(Equal(1))
+			attr = mt.Type.ToObject()["test7"]
+			Ω(attr.Example).Should(BeNumerically("<", 0))
+			attr = mt.Type.ToObject()["test8"]
+			Ω(attr.Example).Should(BeNumerically("<", 0))
+			attr = mt.Type.ToObject()["test9"]
+			Ω(attr.Example).Should(BeNumerically(">", 0))
 		})
 
 		It("produces a media type with HashOf examples", func() {
@@ -35,6 +35,26 @@ import (
 //        Metadata("struct:tag:json", "myName,omitempty")
 //        Metadata("struct:tag:xml", "myName,attr")
 //
+// `length:unit`: sets the unit used by the generated MinLength and MaxLength validations of
+// string attributes. Accepted values are "runes" (the default) and "bytes". Swagger and JSON
+// schema count string lengths in characters so the generated specifications flag byte lengths
+// with the "x-length-unit" extension. A later definition replaces the unit set earlier.
+// Applicable to string attributes only.
+//
+//        Metadata("length:unit", "bytes")
+//
+// `goify:initialism:add`: adds words to the initialisms that goagen renders all uppercase in
+// generated Go identifiers. Words are case insensitive.
+// Applicable to API only.
+//
+//        Metadata("goify:initialism:add", "ACME", "SKU")   // "acme_id" produces "ACMEID"
+//
+// `goify:initialism:remove`: removes words from the initialisms that goagen renders all uppercase
+// in generated Go identifiers. Words are case insensitive.
+// Applicable to API only.
+//
+//        Metadata("goify:initialism:remove", "API")        // "api_id" produces "ApiID"
+//
 // `swagger:generate`: specifies whether Swagger specification should be generated. Defaults to
 // true.
 // Applicable to resources, actions and file servers.
@@ -90,6 +110,12 @@ func Metadata(name string, value ...string) {
 		att := def.Attribute()
 		att.Metadata = appendMetadata(att.Metadata, name, value...)
 	case *design.AttributeDefinition:
+		if name == "length:unit" {
+			if validLengthUnit(def, value) {
+				def.Metadata = setLengthUnit(def.Metadata, value)
+			}
+			return
+		}
 		def.Metadata = appendMetadata(def.Metadata, name, value...)
 	case *design.MediaTypeDefinition:
 		def.Metadata = appendMetadata(def.Metadata, name, value...)
@@ -111,3 +137,29 @@ func Metadata(name string, value ...string) {
 		dslengine.IncompatibleDSL()
 	}
 }
+
+// setLengthUnit returns a copy of metadata where the "length:unit" value is replaced with value.
+// The unit has a single value so a later definition overrides the earlier one. The metadata is
+// copied as it may be shared with the attribute the definition was duplicated from.
+func setLengthUnit(metadata dslengine.MetadataDefinition, value []string) dslengine.MetadataDefinition {
+	res := make(dslengine.MetadataDefinition, len(metadata)+1)
+	for k, v := range metadata {
+		res[k] = v
+	}
+	res["length:unit"] = value
+	return res
+}
+
+// validLengthUnit reports an error and returns false if the "length:unit" metadata value is not
+// supported or if the attribute is not a string.
+func validLengthUnit(att *design.AttributeDefinition, value []string) bool {
+	if att.Type != nil && att.Type.Kind() != design.StringKind {
+		incompatibleAttributeType("length unit", att.Type.Name(), "a string")
+		return false
+	}
+	if len(value) != 1 || (value[0] != "bytes" && value[0] != "runes") {
+		dslengine.ReportError(`invalid "length:unit" metadata value %#v, must be "bytes" or "runes"`, value)
+		return false
+	}
+	return true
+}
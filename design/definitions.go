@@ -1060,6 +1060,19 @@ func (a *AttributeDefinition) IsPrimitivePointer(attName string) bool {
 	return false
 }
 
+// IsComparable returns true if values of the attribute type can be compared with the Go
+// equality operator when generated, i.e. the type is Boolean, Integer, Number, String or UUID.
+func (a *AttributeDefinition) IsComparable() bool {
+	if a.Type == nil {
+		return false
+	}
+	switch a.Type.Kind() {
+	case BooleanKind, IntegerKind, NumberKind, StringKind, UUIDKind:
+		return true
+	}
+	return false
+}
+
 // IsInterface returns true if the field generated for the given attribute has
 // an interface type that should not be referenced as a "*interface{}" pointer.
 // The target attribute must be an object.
@@ -1164,6 +1177,15 @@ func (a *AttributeDefinition) IsReadOnly() bool {
 	return false
 }
 
+// IsLengthInBytes returns true if the length validations of the attribute apply to the number of
+// bytes rather than the number of runes, i.e. if the "length:unit" metadata is set to "bytes".
+func (a *AttributeDefinition) IsLengthInBytes() bool {
+	if unit, ok := a.Metadata["length:unit"]; ok && len(unit) > 0 {
+		return unit[0] == "bytes"
+	}
+	return false
+}
+
 func (a *AttributeDefinition) arrayExample(rand *RandomGenerator, seen []string) interface{} {
 	ary := a.Type.ToArray()
 	ln := newExampleGenerator(a, rand).ExampleLength()
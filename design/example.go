@@ -54,7 +54,9 @@ func (eg *exampleGenerator) Generate(seen []string) interface{} {
 		if hasMinMax {
 			if example == nil {
 				example = eg.generateValidatedMinMaxValueExample()
-			} else if !eg.checkMinMaxValueValidation(example) {
+			}
+			// Generated values may still land on an exclusive bound, retry if so.
+			if !eg.checkMinMaxValueValidation(example) {
 				continue
 			}
 		}
@@ -218,21 +220,22 @@ func (eg *exampleGenerator) checkMinMaxValueValidation(example interface{}) bool
 	if !eg.hasMinMaxValidation() {
 		return true
 	}
-	valid := true
+	var v float64
+	switch actual := example.(type) {
+	case int:
+		v = float64(actual)
+	case float64:
+		v = actual
+	default:
+		return true
+	}
 	if min := eg.a.Validation.Minimum; min != nil {
-		if v, ok := example.(int); ok && float64(v) < *min {
-			valid = false
-		} else if v, ok := example.(float64); ok && v < *min {
-			valid = false
+		if v < *min || (eg.a.Validation.ExclusiveMinimum && v == *min) {
+			return false
 		}
 	}
-	if !valid {
-		return false
-	}
 	if max := eg.a.Validation.Maximum; max != nil {
-		if v, ok := example.(int); ok && float64(v) > *max {
-			return false
-		} else if v, ok := example.(float64); ok && v > *max {
+		if v > *max || (eg.a.Validation.ExclusiveMaximum && v == *max) {
 			return false
 		}
 	}
@@ -250,14 +253,20 @@ func (eg *exampleGenerator) generateValidatedMinMaxValueExample() interface{} {
 	if eg.a.Validation.Maximum != nil {
 		max = *eg.a.Validation.Maximum
 	}
+	if eg.a.Type.Kind() == IntegerKind {
+		// Exclusive bounds on integers are equivalent to the next inclusive bounds.
+		if eg.a.Validation.ExclusiveMinimum {
+			min++
+		}
+		if eg.a.Validation.ExclusiveMaximum {
+			max--
+		}
+	}
 	if math.IsInf(min, 1) {
 		if eg.a.Type.Kind() == IntegerKind {
-			if max == 0 {
-				return int(max) - eg.r.Int()%3
-			}
-			return eg.r.Int() % int(max)
+			return int(max) - eg.r.Int()%3
 		}
-		return eg.r.Float64() * max
+		return max - eg.r.Float64()*math.Max(math.Abs(max), 1)
 	} else if math.IsInf(max, -1) {
 		if eg.a.Type.Kind() == IntegerKind {
 			if min == 0 {
@@ -265,7 +274,7 @@ func (eg *exampleGenerator) generateValidatedMinMaxValueExample() interface{} {
 			}
 			return int(min) + eg.r.Int()%int(min)
 		}
-		return min + eg.r.Float64()*min
+		return min + eg.r.Float64()*math.Max(math.Abs(min), 1)
 	} else if min < max {
 		if eg.a.Type.Kind() == IntegerKind {
 			return int(min) + eg.r.Int()%int(max-min)
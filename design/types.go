@@ -841,8 +841,23 @@ func (m *MediaTypeDefinition) projectSingle(view, canonical string) (p *MediaTyp
 				required = append(required, n)
 			}
 		}
+		// Exactly one of groups can only be checked if the view has all their fields.
+		var groups [][]string
+		for _, names := range m.Validation.ExactlyOneOf {
+			all := true
+			for _, n := range names {
+				if _, ok := viewObj[n]; !ok {
+					all = false
+					break
+				}
+			}
+			if all {
+				groups = append(groups, names)
+			}
+		}
 		val = m.Validation.Dup()
 		val.Required = required
+		val.ExactlyOneOf = groups
 	}
 
 	// Compute description
@@ -206,6 +206,33 @@ var _ = Describe("Project", func() {
 			})
 		})
 
+		Context("with an exactly one of group", func() {
+			BeforeEach(func() {
+				mt.Validation = &dslengine.ValidationDefinition{ExactlyOneOf: [][]string{{"att1", "att2"}}}
+			})
+
+			Context("using the default view", func() {
+				BeforeEach(func() {
+					view = "default"
+				})
+
+				It("keeps the group", func() {
+					Ω(prErr).ShouldNot(HaveOccurred())
+					Ω(projected.Validation.ExactlyOneOf).Should(Equal([][]string{{"att1", "att2"}}))
+				})
+			})
+
+			Context("using the tiny view", func() {
+				BeforeEach(func() {
+					view = "tiny"
+				})
+
+				It("drops the group", func() {
+					Ω(prErr).ShouldNot(HaveOccurred())
+					Ω(projected.Validation.ExactlyOneOf).Should(BeEmpty())
+				})
+			})
+		})
 	})
 
 	Context("with a media type with a links attribute", func() {
@@ -3,6 +3,7 @@ package design
 import (
 	"fmt"
 	"go/build"
+	"math"
 	"mime"
 	"net/url"
 	"os"
@@ -79,6 +80,7 @@ func (a *APIDefinition) Validate() error {
 	verr := new(dslengine.ValidationErrors)
 	if a.Params != nil {
 		verr.Merge(a.Params.Validate("base parameters", a))
+		verr.Merge(validateParamsGroups(a.Params, "base parameters", a))
 	}
 
 	a.validateContact(verr)
@@ -224,7 +226,9 @@ func (r *ResourceDefinition) Validate() *dslengine.ValidationErrors {
 	}
 	if r.Params != nil {
 		verr.Merge(r.Params.Validate("resource parameters", r))
+		verr.Merge(validateParamsGroups(r.Params, "resource parameters", r))
 	}
+	verr.Merge(validateParamsGroups(r.Headers, "resource headers", r))
 	for _, origin := range r.Origins {
 		verr.Merge(origin.Validate())
 	}
@@ -341,6 +345,8 @@ func (a *ActionDefinition) Validate() *dslengine.ValidationErrors {
 		}
 	}
 	verr.Merge(a.ValidateParams())
+	verr.Merge(validateParamsGroups(a.Params, "action parameters", a))
+	verr.Merge(validateParamsGroups(a.Headers, "action headers", a))
 	if a.Payload != nil {
 		verr.Merge(a.Payload.Validate("action payload", a))
 		if HasFile(a.Payload.Type) && a.PayloadMultipart != true {
@@ -446,6 +452,23 @@ func (a *ActionDefinition) ValidateParams() *dslengine.ValidationErrors {
 	return verr.AsError()
 }
 
+// validateParamsGroups reports the exactly one of and required if validations defined on params
+// or headers. The generated code validates each param and header on its own so these validations
+// would be ignored.
+func validateParamsGroups(att *AttributeDefinition, ctx string, parent dslengine.Definition) *dslengine.ValidationErrors {
+	if att == nil || att.Validation == nil {
+		return nil
+	}
+	verr := new(dslengine.ValidationErrors)
+	if len(att.Validation.ExactlyOneOf) > 0 {
+		verr.Add(parent, "%s cannot define exactly one of validations, use a payload instead", ctx)
+	}
+	if len(att.Validation.RequiredIf) > 0 {
+		verr.Add(parent, "%s cannot define required if validations, use a payload instead", ctx)
+	}
+	return verr.AsError()
+}
+
 // validated keeps track of validated attributes to handle cyclical definitions.
 var validated = make(map[*AttributeDefinition]bool)
 
@@ -481,6 +504,16 @@ func (a *AttributeDefinition) Validate(ctx string, parent dslengine.Definition)
 			verr.Add(parent, "%sdefault value %#v is not one of the accepted values: %#v", ctx, a.DefaultValue, a.Validation.Values)
 		}
 	}
+	if a.Type.Kind() == IntegerKind && a.Validation != nil {
+		for _, bound := range []*float64{a.Validation.Minimum, a.Validation.Maximum} {
+			if bound != nil && *bound != math.Trunc(*bound) {
+				verr.Add(parent, "%sbound %v of integer attribute must be an integer", ctx, *bound)
+			}
+		}
+	}
+	if a.HasEmptyRange() {
+		verr.Add(parent, "%sno value satisfies both the minimum %v and the maximum %v", ctx, *a.Validation.Minimum, *a.Validation.Maximum)
+	}
 	o := a.Type.ToObject()
 	if o != nil {
 		for _, n := range a.AllRequired() {
@@ -495,6 +528,33 @@ func (a *AttributeDefinition) Validate(ctx string, parent dslengine.Definition)
 				verr.Add(parent, `%srequired field "%s" does not exist`, ctx, n)
 			}
 		}
+		if a.Validation != nil {
+			for _, names := range a.Validation.ExactlyOneOf {
+				for _, n := range names {
+					if _, ok := o[n]; !ok {
+						verr.Add(parent, `%sexactly one of field "%s" does not exist`, ctx, n)
+					} else if a.IsRequired(n) || a.HasDefaultValue(n) {
+						verr.Add(parent, `%sexactly one of field "%s" cannot be required or have a default value`, ctx, n)
+					}
+				}
+			}
+			for _, r := range a.Validation.RequiredIf {
+				if catt, ok := o[r.Attribute]; !ok {
+					verr.Add(parent, `%srequired if field "%s" does not exist`, ctx, r.Attribute)
+				} else if k := catt.Type.Kind(); k != BooleanKind && k != IntegerKind && k != NumberKind && k != StringKind {
+					verr.Add(parent, `%srequired if field "%s" must be of type boolean, integer, number or string`, ctx, r.Attribute)
+				} else if !catt.Type.IsCompatible(r.Value) {
+					verr.Add(parent, `%srequired if value %#v is incompatible with the type of field "%s"`, ctx, r.Value, r.Attribute)
+				}
+				for _, n := range r.Required {
+					if _, ok := o[n]; !ok {
+						verr.Add(parent, `%sconditionally required field "%s" does not exist`, ctx, n)
+					} else if a.IsRequired(n) || a.HasDefaultValue(n) {
+						verr.Add(parent, `%sconditionally required field "%s" cannot be required or have a default value`, ctx, n)
+					}
+				}
+			}
+		}
 		for n, att := range o {
 			ctx = fmt.Sprintf("field %s", n)
 			verr.Merge(att.Validate(ctx, parent))
@@ -503,18 +563,57 @@ func (a *AttributeDefinition) Validate(ctx string, parent dslengine.Definition)
 		if a.Type.IsArray() {
 			elemType := a.Type.ToArray().ElemType
 			verr.Merge(elemType.Validate(ctx, a))
+			if a.Validation != nil && a.Validation.UniqueItems && !elemType.IsComparable() {
+				verr.Add(parent, "%sunique items validation requires elements of type boolean, integer, number, string or UUID", ctx)
+			}
+			if a.Validation != nil && a.Validation.UniqueKey != "" {
+				key := a.Validation.UniqueKey
+				if eo := elemType.Type.ToObject(); eo == nil {
+					verr.Add(parent, `%sunique key "%s" requires an array of objects`, ctx, key)
+				} else if katt, ok := eo[key]; !ok {
+					verr.Add(parent, `%sunique key field "%s" does not exist`, ctx, key)
+				} else if !katt.IsComparable() {
+					verr.Add(parent, `%sunique key field "%s" must be of type boolean, integer, number, string or UUID`, ctx, key)
+				}
+			}
 		}
 	}
 
 	return verr.AsError()
 }
 
+// HasEmptyRange returns true if the attribute has both a minimum and a maximum validation and no
+// value of the attribute type is both greater than (or equal to) the minimum and less than (or
+// equal to) the maximum.
+func (a *AttributeDefinition) HasEmptyRange() bool {
+	val := a.Validation
+	if val == nil || val.Minimum == nil || val.Maximum == nil {
+		return false
+	}
+	min, max := *val.Minimum, *val.Maximum
+	if a.Type != nil && a.Type.Kind() == IntegerKind {
+		if val.ExclusiveMinimum {
+			min = math.Floor(min) + 1
+		} else {
+			min = math.Ceil(min)
+		}
+		if val.ExclusiveMaximum {
+			max = math.Ceil(max) - 1
+		} else {
+			max = math.Floor(max)
+		}
+		return min > max
+	}
+	return min > max || (min == max && (val.ExclusiveMinimum || val.ExclusiveMaximum))
+}
+
 // Validate checks that the response definition is consistent: its status is set and the media
 // type definition if any is valid.
 func (r *ResponseDefinition) Validate() *dslengine.ValidationErrors {
 	verr := new(dslengine.ValidationErrors)
 	if r.Headers != nil {
 		verr.Merge(r.Headers.Validate("response headers", r))
+		verr.Merge(validateParamsGroups(r.Headers, "response headers", r))
 	}
 	if r.Status == 0 {
 		verr.Add(r, "response status not defined")
@@ -217,6 +217,103 @@ var _ = Describe("Validation", func() {
 			})
 		})
 
+		Context("with exclusive min and max value validations", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, Number, func() {
+						ExclusiveMinimum(0)
+						ExclusiveMaximum(1)
+					})
+				}
+			})
+
+			It("records the validations", func() {
+				Ω(dslengine.Errors).ShouldNot(HaveOccurred())
+				Ω(att.Validation).ShouldNot(BeNil())
+				Ω(att.Validation.ExclusiveMinimum).Should(BeTrue())
+				Ω(att.Validation.ExclusiveMaximum).Should(BeTrue())
+			})
+		})
+
+		Context("with exclusive min and max value validations that no integer satisfies", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, Integer, func() {
+						ExclusiveMinimum(0)
+						ExclusiveMaximum(1)
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+			})
+		})
+
+		Context("with a length unit in bytes", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, String, func() {
+						MaxLength(3)
+						Metadata("length:unit", "bytes")
+					})
+				}
+			})
+
+			It("records the metadata", func() {
+				Ω(dslengine.Errors).ShouldNot(HaveOccurred())
+				Ω(att.IsLengthInBytes()).Should(BeTrue())
+			})
+		})
+
+		Context("with a length unit overridden in bytes", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, String, func() {
+						MaxLength(3)
+						Metadata("length:unit", "runes")
+						Metadata("length:unit", "bytes")
+					})
+				}
+			})
+
+			It("uses the last length unit", func() {
+				Ω(dslengine.Errors).ShouldNot(HaveOccurred())
+				Ω(att.Metadata["length:unit"]).Should(Equal([]string{"bytes"}))
+				Ω(att.IsLengthInBytes()).Should(BeTrue())
+			})
+		})
+
+		Context("with an unknown length unit", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, String, func() {
+						MaxLength(3)
+						Metadata("length:unit", "byte")
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+			})
+		})
+
+		Context("with a length unit on a non string attribute", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, ArrayOf(String), func() {
+						MaxLength(3)
+						Metadata("length:unit", "bytes")
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+			})
+		})
+
 		Context("with a valid min length validation", func() {
 			BeforeEach(func() {
 				dsl = func() {
@@ -277,6 +374,97 @@ var _ = Describe("Validation", func() {
 			})
 		})
 
+		Context("with a unique items validation", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, ArrayOf(String), func() {
+						UniqueItems()
+					})
+				}
+			})
+
+			It("records the validation", func() {
+				Ω(dslengine.Errors).ShouldNot(HaveOccurred())
+				Ω(att.Validation).ShouldNot(BeNil())
+				Ω(att.Validation.UniqueItems).Should(BeTrue())
+			})
+		})
+
+		Context("with a unique items validation on an array of hashes", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, ArrayOf(HashOf(String, String)), func() {
+						UniqueItems()
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+				Ω(dslengine.Errors.Error()).Should(ContainSubstring("unique items validation requires elements"))
+			})
+		})
+
+		Context("with a unique items validation on a string", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, String, func() {
+						UniqueItems()
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+			})
+		})
+
+		Context("with a unique key validation", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, ArrayOf(Object{"sku": &AttributeDefinition{Type: String}}), func() {
+						UniqueKey("sku")
+					})
+				}
+			})
+
+			It("records the validation", func() {
+				Ω(dslengine.Errors).ShouldNot(HaveOccurred())
+				Ω(att.Validation).ShouldNot(BeNil())
+				Ω(att.Validation.UniqueKey).Should(Equal("sku"))
+			})
+		})
+
+		Context("with a unique key validation on a missing field", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, ArrayOf(Object{"id": &AttributeDefinition{Type: String}}), func() {
+						UniqueKey("sku")
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+				Ω(dslengine.Errors.Error()).Should(ContainSubstring(`unique key field "sku" does not exist`))
+			})
+		})
+
+		Context("with a unique key validation on an array of hashes", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, ArrayOf(HashOf(String, String)), func() {
+						UniqueKey("sku")
+					})
+				}
+			})
+
+			It("requires an array of objects", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+				Ω(dslengine.Errors.Error()).Should(ContainSubstring(`unique key "sku" requires an array of objects`))
+			})
+		})
+
 		Context("with a required field validation", func() {
 			BeforeEach(func() {
 				dsl = func() {
@@ -291,6 +479,35 @@ var _ = Describe("Validation", func() {
 				Ω(Design.Types["bar"].Validation.Required).Should(Equal([]string{attName}))
 			})
 		})
+
+		Context("with an exactly one of validation", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, String)
+					Attribute("other", String)
+					ExactlyOneOf(attName, "other")
+				}
+			})
+
+			It("records the validation", func() {
+				Ω(dslengine.Errors).ShouldNot(HaveOccurred())
+				Ω(Design.Types["bar"].Validation).ShouldNot(BeNil())
+				Ω(Design.Types["bar"].Validation.ExactlyOneOf).Should(Equal([][]string{{attName, "other"}}))
+			})
+		})
+
+		Context("with an exactly one of validation with a single name", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Attribute(attName, String)
+					ExactlyOneOf(attName)
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+			})
+		})
 	})
 
 	Context("actions with different http methods", func() {
@@ -342,6 +559,74 @@ var _ = Describe("Validation", func() {
 			})
 		})
 
+		Context("which has params with an exactly one of validation", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Params(func() {
+						Param("email", String)
+						Param("phone", String)
+						ExactlyOneOf("email", "phone")
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+				Ω(dslengine.Errors.Error()).Should(ContainSubstring("action parameters cannot define exactly one of validations"))
+			})
+		})
+
+		Context("which has headers with an exactly one of validation", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Headers(func() {
+						Header("X-Email", String)
+						Header("X-Phone", String)
+						ExactlyOneOf("X-Email", "X-Phone")
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+				Ω(dslengine.Errors.Error()).Should(ContainSubstring("action headers cannot define exactly one of validations"))
+			})
+		})
+
+		Context("which has params with a required if validation", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Params(func() {
+						Param("channel", String)
+						Param("phone", String)
+						RequiredIf("channel", "sms", "phone")
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+				Ω(dslengine.Errors.Error()).Should(ContainSubstring("action parameters cannot define required if validations"))
+			})
+		})
+
+		Context("which has headers with a required if validation", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Headers(func() {
+						Header("X-Channel", String)
+						Header("X-Phone", String)
+						RequiredIf("X-Channel", "sms", "X-Phone")
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+				Ω(dslengine.Errors.Error()).Should(ContainSubstring("action headers cannot define required if validations"))
+			})
+		})
+
 		Context("which has a file array type param", func() {
 			BeforeEach(func() {
 				dsl = func() {
@@ -392,6 +677,89 @@ var _ = Describe("Validation", func() {
 			})
 		})
 
+		Context("which has a payload with an exactly one of validation on a missing attribute", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Payload(func() {
+						Attribute("email", String)
+						ExactlyOneOf("email", "phone")
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+				Ω(dslengine.Errors.Error()).Should(ContainSubstring(`exactly one of field "phone" does not exist`))
+			})
+		})
+
+		Context("which has a payload with an exactly one of validation on a required attribute", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Payload(func() {
+						Attribute("email", String)
+						Attribute("phone", String)
+						Required("phone")
+						ExactlyOneOf("email", "phone")
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+				Ω(dslengine.Errors.Error()).Should(ContainSubstring(`exactly one of field "phone" cannot be required`))
+			})
+		})
+
+		Context("which has a payload with a required if validation", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Payload(func() {
+						Attribute("channel", String)
+						Attribute("phone", String)
+						RequiredIf("channel", "sms", "phone")
+					})
+				}
+			})
+
+			It("does not produce an error", func() {
+				Ω(dslengine.Errors).ShouldNot(HaveOccurred())
+			})
+		})
+
+		Context("which has a payload with a required if validation on a missing attribute", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Payload(func() {
+						Attribute("channel", String)
+						RequiredIf("channel", "sms", "phone")
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+				Ω(dslengine.Errors.Error()).Should(ContainSubstring(`conditionally required field "phone" does not exist`))
+			})
+		})
+
+		Context("which has a payload with a required if validation with an incompatible value", func() {
+			BeforeEach(func() {
+				dsl = func() {
+					Payload(func() {
+						Attribute("channel", Integer)
+						Attribute("phone", String)
+						RequiredIf("channel", "sms", "phone")
+					})
+				}
+			})
+
+			It("produces an error", func() {
+				Ω(dslengine.Errors).Should(HaveOccurred())
+				Ω(dslengine.Errors.Error()).Should(ContainSubstring(`required if value "sms" is incompatible with the type of field "channel"`))
+			})
+		})
+
 		Context("which has a response contains a file", func() {
 			BeforeEach(func() {
 				dslengine.Reset()
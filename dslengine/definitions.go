@@ -91,18 +91,49 @@ type (
 		// Minimum represents an minimum value validation as described at
 		// http://json-schema.org/latest/json-schema-validation.html#anchor21.
 		Minimum *float64
+		// ExclusiveMinimum indicates whether the Minimum validation excludes the minimum
+		// value as described at
+		// http://json-schema.org/latest/json-schema-validation.html#anchor21.
+		ExclusiveMinimum bool
 		// Maximum represents a maximum value validation as described at
 		// http://json-schema.org/latest/json-schema-validation.html#anchor17.
 		Maximum *float64
+		// ExclusiveMaximum indicates whether the Maximum validation excludes the maximum
+		// value as described at
+		// http://json-schema.org/latest/json-schema-validation.html#anchor17.
+		ExclusiveMaximum bool
 		// MinLength represents an minimum length validation as described at
 		// http://json-schema.org/latest/json-schema-validation.html#anchor29.
 		MinLength *int
 		// MaxLength represents an maximum length validation as described at
 		// http://json-schema.org/latest/json-schema-validation.html#anchor26.
 		MaxLength *int
+		// UniqueItems represents a unique items validation as described at
+		// http://json-schema.org/latest/json-schema-validation.html#anchor49.
+		UniqueItems bool
+		// UniqueKey is the name of the field of the object elements of array attributes whose
+		// values must be unique.
+		UniqueKey string
 		// Required list the required fields of object attributes as described at
 		// http://json-schema.org/latest/json-schema-validation.html#anchor61.
 		Required []string
+		// ExactlyOneOf lists groups of fields of object attributes. Exactly one field of each
+		// group must be set.
+		ExactlyOneOf [][]string
+		// RequiredIf lists the fields of object attributes that are required only when
+		// another field has a given value.
+		RequiredIf []*ConditionalRequiredDefinition
+	}
+
+	// ConditionalRequiredDefinition lists the fields that are required when another field is
+	// set to a given value.
+	ConditionalRequiredDefinition struct {
+		// Attribute is the name of the field whose value triggers the requirement.
+		Attribute string
+		// Value is the value of Attribute that triggers the requirement.
+		Value interface{}
+		// Required lists the fields required when Attribute is set to Value.
+		Required []string
 	}
 )
 
@@ -137,9 +168,15 @@ func (v *ValidationDefinition) Merge(other *ValidationDefinition) {
 	}
 	if v.Minimum == nil || (other.Minimum != nil && *v.Minimum > *other.Minimum) {
 		v.Minimum = other.Minimum
+		v.ExclusiveMinimum = other.ExclusiveMinimum
+	} else if other.Minimum != nil && *v.Minimum == *other.Minimum {
+		v.ExclusiveMinimum = v.ExclusiveMinimum && other.ExclusiveMinimum
 	}
 	if v.Maximum == nil || (other.Maximum != nil && *v.Maximum < *other.Maximum) {
 		v.Maximum = other.Maximum
+		v.ExclusiveMaximum = other.ExclusiveMaximum
+	} else if other.Maximum != nil && *v.Maximum == *other.Maximum {
+		v.ExclusiveMaximum = v.ExclusiveMaximum && other.ExclusiveMaximum
 	}
 	if v.MinLength == nil || (other.MinLength != nil && *v.MinLength > *other.MinLength) {
 		v.MinLength = other.MinLength
@@ -147,7 +184,17 @@ func (v *ValidationDefinition) Merge(other *ValidationDefinition) {
 	if v.MaxLength == nil || (other.MaxLength != nil && *v.MaxLength < *other.MaxLength) {
 		v.MaxLength = other.MaxLength
 	}
+	v.UniqueItems = v.UniqueItems || other.UniqueItems
+	if v.UniqueKey == "" {
+		v.UniqueKey = other.UniqueKey
+	}
 	v.AddRequired(other.Required)
+	for _, names := range other.ExactlyOneOf {
+		v.AddExactlyOneOf(names)
+	}
+	for _, r := range other.RequiredIf {
+		v.AddRequiredIf(r.Attribute, r.Value, r.Required)
+	}
 }
 
 // AddRequired merges the required fields from other into v
@@ -166,15 +213,65 @@ func (v *ValidationDefinition) AddRequired(required []string) {
 	}
 }
 
+// AddExactlyOneOf adds the group of fields names to v unless v already has the same group.
+func (v *ValidationDefinition) AddExactlyOneOf(names []string) {
+	for _, group := range v.ExactlyOneOf {
+		if len(group) != len(names) {
+			continue
+		}
+		same := true
+		for i, n := range group {
+			if names[i] != n {
+				same = false
+				break
+			}
+		}
+		if same {
+			return
+		}
+	}
+	v.ExactlyOneOf = append(v.ExactlyOneOf, names)
+}
+
+// AddRequiredIf merges the fields required when attribute is set to value into v.
+func (v *ValidationDefinition) AddRequiredIf(attribute string, value interface{}, required []string) {
+	var cond *ConditionalRequiredDefinition
+	for _, r := range v.RequiredIf {
+		if r.Attribute == attribute && r.Value == value {
+			cond = r
+			break
+		}
+	}
+	if cond == nil {
+		cond = &ConditionalRequiredDefinition{Attribute: attribute, Value: value}
+		v.RequiredIf = append(v.RequiredIf, cond)
+	}
+	for _, n := range required {
+		found := false
+		for _, nn := range cond.Required {
+			if n == nn {
+				found = true
+				break
+			}
+		}
+		if !found {
+			cond.Required = append(cond.Required, n)
+		}
+	}
+}
+
 // HasRequiredOnly returns true if the validation only has the Required field with a non-zero value.
 func (v *ValidationDefinition) HasRequiredOnly() bool {
 	if len(v.Values) > 0 {
 		return false
 	}
-	if v.Format != "" || v.Pattern != "" {
+	if v.Format != "" || v.Pattern != "" || v.UniqueKey != "" {
+		return false
+	}
+	if (v.Minimum != nil) || (v.Maximum != nil) || (v.MaxLength != nil) || v.UniqueItems {
 		return false
 	}
-	if (v.Minimum != nil) || (v.Maximum != nil) || (v.MaxLength != nil) {
+	if len(v.ExactlyOneOf) > 0 || len(v.RequiredIf) > 0 {
 		return false
 	}
 	return true
@@ -183,13 +280,19 @@ func (v *ValidationDefinition) HasRequiredOnly() bool {
 // Dup makes a shallow dup of the validation.
 func (v *ValidationDefinition) Dup() *ValidationDefinition {
 	return &ValidationDefinition{
-		Values:    v.Values,
-		Format:    v.Format,
-		Pattern:   v.Pattern,
-		Minimum:   v.Minimum,
-		Maximum:   v.Maximum,
-		MinLength: v.MinLength,
-		MaxLength: v.MaxLength,
-		Required:  v.Required,
+		Values:           v.Values,
+		Format:           v.Format,
+		Pattern:          v.Pattern,
+		Minimum:          v.Minimum,
+		ExclusiveMinimum: v.ExclusiveMinimum,
+		Maximum:          v.Maximum,
+		ExclusiveMaximum: v.ExclusiveMaximum,
+		MinLength:        v.MinLength,
+		MaxLength:        v.MaxLength,
+		UniqueItems:      v.UniqueItems,
+		UniqueKey:        v.UniqueKey,
+		Required:         v.Required,
+		ExactlyOneOf:     v.ExactlyOneOf,
+		RequiredIf:       v.RequiredIf,
 	}
 }
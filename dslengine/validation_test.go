@@ -275,3 +275,130 @@ var _ = Describe("Validation", func() {
 		})
 	})
 })
+
+var _ = Describe("ValidationDefinition", func() {
+	var v, other *dslengine.ValidationDefinition
+
+	Describe("Merge", func() {
+		JustBeforeEach(func() {
+			v.Merge(other)
+		})
+
+		Context("with equal minimums where only one is exclusive", func() {
+			BeforeEach(func() {
+				min, otherMin := 1.0, 1.0
+				v = &dslengine.ValidationDefinition{Minimum: &min, ExclusiveMinimum: true}
+				other = &dslengine.ValidationDefinition{Minimum: &otherMin}
+			})
+
+			It("keeps the inclusive minimum", func() {
+				Ω(*v.Minimum).Should(Equal(1.0))
+				Ω(v.ExclusiveMinimum).Should(BeFalse())
+			})
+		})
+
+		Context("with equal maximums where only one is exclusive", func() {
+			BeforeEach(func() {
+				max, otherMax := 1.0, 1.0
+				v = &dslengine.ValidationDefinition{Maximum: &max, ExclusiveMaximum: true}
+				other = &dslengine.ValidationDefinition{Maximum: &otherMax}
+			})
+
+			It("keeps the inclusive maximum", func() {
+				Ω(*v.Maximum).Should(Equal(1.0))
+				Ω(v.ExclusiveMaximum).Should(BeFalse())
+			})
+		})
+
+		Context("with equal exclusive bounds", func() {
+			BeforeEach(func() {
+				min, max, otherMin, otherMax := 0.0, 1.0, 0.0, 1.0
+				v = &dslengine.ValidationDefinition{Minimum: &min, ExclusiveMinimum: true, Maximum: &max, ExclusiveMaximum: true}
+				other = &dslengine.ValidationDefinition{Minimum: &otherMin, ExclusiveMinimum: true, Maximum: &otherMax, ExclusiveMaximum: true}
+			})
+
+			It("keeps the exclusive bounds", func() {
+				Ω(v.ExclusiveMinimum).Should(BeTrue())
+				Ω(v.ExclusiveMaximum).Should(BeTrue())
+			})
+		})
+
+		Context("with a looser exclusive minimum", func() {
+			BeforeEach(func() {
+				min, otherMin := 1.0, 0.0
+				v = &dslengine.ValidationDefinition{Minimum: &min}
+				other = &dslengine.ValidationDefinition{Minimum: &otherMin, ExclusiveMinimum: true}
+			})
+
+			It("uses the other minimum and its exclusivity", func() {
+				Ω(*v.Minimum).Should(Equal(0.0))
+				Ω(v.ExclusiveMinimum).Should(BeTrue())
+			})
+		})
+
+		Context("with exactly one of groups", func() {
+			BeforeEach(func() {
+				v = &dslengine.ValidationDefinition{ExactlyOneOf: [][]string{{"a", "b"}}}
+				other = &dslengine.ValidationDefinition{ExactlyOneOf: [][]string{{"a", "b"}, {"c", "d"}}}
+			})
+
+			It("adds the missing groups", func() {
+				Ω(v.ExactlyOneOf).Should(Equal([][]string{{"a", "b"}, {"c", "d"}}))
+			})
+		})
+
+		Context("with required if conditions", func() {
+			BeforeEach(func() {
+				v = &dslengine.ValidationDefinition{RequiredIf: []*dslengine.ConditionalRequiredDefinition{
+					{Attribute: "a", Value: "x", Required: []string{"b"}},
+				}}
+				other = &dslengine.ValidationDefinition{RequiredIf: []*dslengine.ConditionalRequiredDefinition{
+					{Attribute: "a", Value: "x", Required: []string{"b", "c"}},
+					{Attribute: "a", Value: "y", Required: []string{"d"}},
+				}}
+			})
+
+			It("merges the required fields of identical conditions", func() {
+				Ω(v.RequiredIf).Should(HaveLen(2))
+				Ω(v.RequiredIf[0].Required).Should(Equal([]string{"b", "c"}))
+				Ω(v.RequiredIf[1].Value).Should(Equal("y"))
+				Ω(v.RequiredIf[1].Required).Should(Equal([]string{"d"}))
+			})
+		})
+	})
+
+	Describe("Dup", func() {
+		BeforeEach(func() {
+			min, max := 0.0, 1.0
+			v = &dslengine.ValidationDefinition{Minimum: &min, ExclusiveMinimum: true, Maximum: &max, ExclusiveMaximum: true}
+		})
+
+		It("copies the exclusive bounds", func() {
+			dup := v.Dup()
+			Ω(dup.Minimum).Should(Equal(v.Minimum))
+			Ω(dup.ExclusiveMinimum).Should(BeTrue())
+			Ω(dup.Maximum).Should(Equal(v.Maximum))
+			Ω(dup.ExclusiveMaximum).Should(BeTrue())
+		})
+
+		It("copies the unique items flag", func() {
+			v.UniqueItems = true
+			Ω(v.Dup().UniqueItems).Should(BeTrue())
+		})
+
+		It("copies the unique key", func() {
+			v.UniqueKey = "sku"
+			Ω(v.Dup().UniqueKey).Should(Equal("sku"))
+		})
+
+		It("copies the exactly one of groups", func() {
+			v.ExactlyOneOf = [][]string{{"a", "b"}}
+			Ω(v.Dup().ExactlyOneOf).Should(Equal([][]string{{"a", "b"}}))
+		})
+
+		It("copies the required if conditions", func() {
+			v.RequiredIf = []*dslengine.ConditionalRequiredDefinition{{Attribute: "a", Value: true, Required: []string{"b"}}}
+			Ω(v.Dup().RequiredIf).Should(Equal(v.RequiredIf))
+		})
+	})
+})
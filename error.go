@@ -189,6 +189,13 @@ func MissingAttributeError(ctx, name string) error {
 	return ErrInvalidRequest(msg, "attribute", name, "parent", ctx)
 }
 
+// MissingConditionalAttributeError is the error produced when a request payload is missing a
+// field that is required because another field is set to a given value.
+func MissingConditionalAttributeError(ctx, name, cond string, value interface{}) error {
+	msg := fmt.Sprintf("attribute %#v of %s is missing and required when %#v is %#v", name, ctx, cond, value)
+	return ErrInvalidRequest(msg, "attribute", name, "parent", ctx, "condition", cond, "value", value)
+}
+
 // MissingHeaderError is the error produced when a request is missing a required header.
 func MissingHeaderError(name string) error {
 	msg := fmt.Sprintf("missing required HTTP header %#v", name)
@@ -231,6 +238,18 @@ func InvalidRangeError(ctx string, target interface{}, value interface{}, min bo
 	return ErrInvalidRequest(msg, "attribute", ctx, "value", target, "comp", comp, "expected", value)
 }
 
+// InvalidExclusiveRangeError is the error produced when the value of a parameter or payload field
+// does not match the exclusive range validation defined in the design. value may be a int or a
+// float64.
+func InvalidExclusiveRangeError(ctx string, target interface{}, value interface{}, min bool) error {
+	comp := "greater than"
+	if !min {
+		comp = "less than"
+	}
+	msg := fmt.Sprintf("%s must be %s %v but got value %#v", ctx, comp, value, target)
+	return ErrInvalidRequest(msg, "attribute", ctx, "value", target, "comp", comp, "expected", value)
+}
+
 // InvalidLengthError is the error produced when the value of a parameter or payload field does
 // not match the length validation defined in the design.
 func InvalidLengthError(ctx string, target interface{}, ln, value int, min bool) error {
@@ -242,6 +261,31 @@ func InvalidLengthError(ctx string, target interface{}, ln, value int, min bool)
 	return ErrInvalidRequest(msg, "attribute", ctx, "value", target, "len", ln, "comp", comp, "expected", value)
 }
 
+// InvalidExactlyOneOfError is the error produced when the number of fields set in a request payload
+// among a group of mutually exclusive fields is not exactly one.
+func InvalidExactlyOneOfError(ctx string, names []string, count int) error {
+	elems := make([]string, len(names))
+	for i, n := range names {
+		elems[i] = fmt.Sprintf("%#v", n)
+	}
+	msg := fmt.Sprintf("exactly one of %s of %s must be set but got %d", strings.Join(elems, ", "), ctx, count)
+	return ErrInvalidRequest(msg, "attribute", ctx, "expected", strings.Join(elems, ", "), "count", count)
+}
+
+// InvalidUniqueItemsError is the error produced when an array in a request payload contains the
+// same value more than once and the design defines a unique items validation.
+func InvalidUniqueItemsError(ctx string, value interface{}) error {
+	msg := fmt.Sprintf("elements of %s must be unique but got value %#v more than once", ctx, value)
+	return ErrInvalidRequest(msg, "attribute", ctx, "value", value)
+}
+
+// InvalidUniqueKeyError is the error produced when two elements of an array in a request payload
+// have the same value for the field that the design defines as unique key.
+func InvalidUniqueKeyError(ctx, key string, value interface{}) error {
+	msg := fmt.Sprintf("elements of %s must have unique %#v values but got value %#v more than once", ctx, key, value)
+	return ErrInvalidRequest(msg, "attribute", ctx, "key", key, "value", value)
+}
+
 // NoAuthMiddleware is the error produced when goa is unable to lookup a auth middleware for a
 // security scheme defined in the design.
 func NoAuthMiddleware(schemeName string) error {
@@ -106,6 +106,25 @@ var _ = Describe("MissingAttributeError", func() {
 	})
 })
 
+var _ = Describe("MissingConditionalAttributeError", func() {
+	var valErr error
+	ctx := "ctx"
+	name := "phone"
+
+	JustBeforeEach(func() {
+		valErr = MissingConditionalAttributeError(ctx, name, "channel", "sms")
+	})
+
+	It("creates a http error", func() {
+		Ω(valErr).ShouldNot(BeNil())
+		Ω(valErr).Should(BeAssignableToTypeOf(&ErrorResponse{}))
+		err := valErr.(*ErrorResponse)
+		Ω(err.Detail).Should(ContainSubstring(ctx))
+		Ω(err.Detail).Should(ContainSubstring(name))
+		Ω(err.Detail).Should(ContainSubstring(`when "channel" is "sms"`))
+	})
+})
+
 var _ = Describe("MissingHeaderError", func() {
 	var valErr error
 	name := "param"
@@ -269,6 +288,47 @@ var _ = Describe("InvalidRangeError", func() {
 	})
 })
 
+var _ = Describe("InvalidExclusiveRangeError", func() {
+	var valErr error
+	var min bool
+
+	ctx := "ctx"
+	target := 42
+	value := 42
+
+	JustBeforeEach(func() {
+		valErr = InvalidExclusiveRangeError(ctx, target, value, min)
+	})
+
+	Context("with a minimum", func() {
+		BeforeEach(func() {
+			min = true
+		})
+
+		It("creates a http error", func() {
+			Ω(valErr).ShouldNot(BeNil())
+			Ω(valErr).Should(BeAssignableToTypeOf(&ErrorResponse{}))
+			err := valErr.(*ErrorResponse)
+			Ω(err.Detail).Should(ContainSubstring(ctx))
+			Ω(err.Detail).Should(ContainSubstring("must be greater than 42"))
+		})
+	})
+
+	Context("with a maximum", func() {
+		BeforeEach(func() {
+			min = false
+		})
+
+		It("creates a http error", func() {
+			Ω(valErr).ShouldNot(BeNil())
+			Ω(valErr).Should(BeAssignableToTypeOf(&ErrorResponse{}))
+			err := valErr.(*ErrorResponse)
+			Ω(err.Detail).Should(ContainSubstring(ctx))
+			Ω(err.Detail).Should(ContainSubstring("must be less than 42"))
+		})
+	})
+})
+
 var _ = Describe("InvalidLengthError", func() {
 	const ctx = "ctx"
 	const value = 42
@@ -318,6 +378,63 @@ var _ = Describe("InvalidLengthError", func() {
 	})
 })
 
+var _ = Describe("InvalidExactlyOneOfError", func() {
+	var valErr error
+	ctx := "ctx"
+	names := []string{"foo", "bar"}
+
+	JustBeforeEach(func() {
+		valErr = InvalidExactlyOneOfError(ctx, names, 2)
+	})
+
+	It("creates a http error", func() {
+		Ω(valErr).ShouldNot(BeNil())
+		Ω(valErr).Should(BeAssignableToTypeOf(&ErrorResponse{}))
+		err := valErr.(*ErrorResponse)
+		Ω(err.Detail).Should(ContainSubstring(ctx))
+		Ω(err.Detail).Should(ContainSubstring(`exactly one of "foo", "bar"`))
+		Ω(err.Detail).Should(ContainSubstring("got 2"))
+	})
+})
+
+var _ = Describe("InvalidUniqueItemsError", func() {
+	var valErr error
+	ctx := "ctx"
+	value := "foo"
+
+	JustBeforeEach(func() {
+		valErr = InvalidUniqueItemsError(ctx, value)
+	})
+
+	It("creates a http error", func() {
+		Ω(valErr).ShouldNot(BeNil())
+		Ω(valErr).Should(BeAssignableToTypeOf(&ErrorResponse{}))
+		err := valErr.(*ErrorResponse)
+		Ω(err.Detail).Should(ContainSubstring(ctx))
+		Ω(err.Detail).Should(ContainSubstring(`"foo" more than once`))
+	})
+})
+
+var _ = Describe("InvalidUniqueKeyError", func() {
+	var valErr error
+	ctx := "ctx"
+	key := "sku"
+	value := "foo"
+
+	JustBeforeEach(func() {
+		valErr = InvalidUniqueKeyError(ctx, key, value)
+	})
+
+	It("creates a http error", func() {
+		Ω(valErr).ShouldNot(BeNil())
+		Ω(valErr).Should(BeAssignableToTypeOf(&ErrorResponse{}))
+		err := valErr.(*ErrorResponse)
+		Ω(err.Detail).Should(ContainSubstring(ctx))
+		Ω(err.Detail).Should(ContainSubstring(`unique "sku" values`))
+		Ω(err.Detail).Should(ContainSubstring(`"foo" more than once`))
+	})
+})
+
 // MergeableErrorResponse contains the details of a error response.
 // It implements ServiceMergeableError.
 type MergeableErrorResponse struct {
@@ -296,6 +296,8 @@ func GoTypeDesc(t design.DataType, upper bool) string {
 	}
 }
 
+// commonInitialisms lists the words that Goify renders all uppercase (or all lowercase when they
+// start an unexported identifier). Use AddInitialism and RemoveInitialism to modify it.
 var commonInitialisms = map[string]bool{
 	"API":   true,
 	"ASCII": true,
@@ -338,6 +340,33 @@ var commonInitialisms = map[string]bool{
 	"XSS":   true,
 }
 
+// AddInitialism adds words to the list of initialisms that Goify renders all uppercase, for
+// example adding "acme" causes "acme_id" to produce "ACMEID". Words are case insensitive.
+func AddInitialism(words ...string) {
+	for _, w := range words {
+		commonInitialisms[strings.ToUpper(w)] = true
+	}
+}
+
+// RemoveInitialism removes words from the list of initialisms that Goify renders all uppercase,
+// for example removing "api" causes "api_id" to produce "ApiID" instead of "APIID". Words are case
+// insensitive.
+func RemoveInitialism(words ...string) {
+	for _, w := range words {
+		delete(commonInitialisms, strings.ToUpper(w))
+	}
+}
+
+// ConfigureInitialisms updates the list of initialisms used by Goify with the values of the
+// "goify:initialism:add" and "goify:initialism:remove" metadata of the given API definition.
+func ConfigureInitialisms(api *design.APIDefinition) {
+	if api == nil {
+		return
+	}
+	AddInitialism(api.Metadata["goify:initialism:add"]...)
+	RemoveInitialism(api.Metadata["goify:initialism:remove"]...)
+}
+
 // removeTrailingInvalid removes trailing invalid identifiers from runes.
 func removeTrailingInvalid(runes []rune) []rune {
 	valid := len(runes) - 1
@@ -418,8 +447,8 @@ func Goify(str string, firstUpper bool) string {
 				u = strings.ToLower(u)
 			}
 
-			// All the common initialisms are ASCII,
-			// so we can replace the bytes exactly.
+			// Changing the case maps each rune to exactly one rune,
+			// so we can replace the runes exactly.
 			copy(runes[w:], []rune(u))
 		} else if w > 0 && strings.ToLower(word) == word {
 			// already all lowercase, and not the first word, so uppercase the first character.
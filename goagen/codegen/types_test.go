@@ -171,6 +171,57 @@ var _ = Describe("code generation", func() {
 				})
 			})
 
+			Context("with a custom initialism", func() {
+				BeforeEach(func() {
+					codegen.AddInitialism("acme")
+					firstUpper = true
+					str = "acme_id"
+					expected = "ACMEID"
+				})
+				AfterEach(func() {
+					codegen.RemoveInitialism("ACME")
+				})
+				It("uppercases the initialism", func() {
+					Ω(goified).Should(Equal(expected))
+				})
+			})
+
+			Context("with a removed initialism", func() {
+				BeforeEach(func() {
+					codegen.RemoveInitialism("Api")
+					firstUpper = true
+					str = "api_id"
+					expected = "ApiID"
+				})
+				AfterEach(func() {
+					codegen.AddInitialism("API")
+				})
+				It("camelcases the word", func() {
+					Ω(goified).Should(Equal(expected))
+				})
+			})
+
+			Context("with initialisms configured in the API metadata", func() {
+				BeforeEach(func() {
+					codegen.ConfigureInitialisms(&APIDefinition{
+						Metadata: dslengine.MetadataDefinition{
+							"goify:initialism:add":    {"acme"},
+							"goify:initialism:remove": {"api"},
+						},
+					})
+					firstUpper = true
+					str = "acme_api_id"
+					expected = "ACMEApiID"
+				})
+				AfterEach(func() {
+					codegen.RemoveInitialism("ACME")
+					codegen.AddInitialism("API")
+				})
+				It("applies the metadata", func() {
+					Ω(goified).Should(Equal(expected))
+				})
+			})
+
 		})
 
 	})
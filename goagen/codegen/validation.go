@@ -18,6 +18,10 @@ var (
 	minMaxValT   *template.Template
 	lengthValT   *template.Template
 	requiredValT *template.Template
+	oneOfValT    *template.Template
+	uniqueValT   *template.Template
+	uniqueKeyT   *template.Template
+	requiredIfT  *template.Template
 )
 
 //  init instantiates the templates.
@@ -49,6 +53,18 @@ func init() {
 	if requiredValT, err = template.New("required").Funcs(fm).Parse(requiredValTmpl); err != nil {
 		panic(err)
 	}
+	if oneOfValT, err = template.New("oneOf").Funcs(fm).Parse(oneOfValTmpl); err != nil {
+		panic(err)
+	}
+	if uniqueValT, err = template.New("unique").Funcs(fm).Parse(uniqueValTmpl); err != nil {
+		panic(err)
+	}
+	if uniqueKeyT, err = template.New("uniqueKey").Funcs(fm).Parse(uniqueKeyTmpl); err != nil {
+		panic(err)
+	}
+	if requiredIfT, err = template.New("requiredIf").Funcs(fm).Parse(requiredIfTmpl); err != nil {
+		panic(err)
+	}
 }
 
 // Validator is the code generator for the 'Validate' type methods.
@@ -343,7 +359,7 @@ func ValidationChecker(att *design.AttributeDefinition, nonzero, required, hasDe
 		"context":   context,
 		"target":    target,
 		"targetVal": t,
-		"string":    att.Type.Kind() == design.StringKind,
+		"runes":     att.Type.Kind() == design.StringKind && !att.IsLengthInBytes(),
 		"array":     att.Type.IsArray(),
 		"hash":      att.Type.IsHash(),
 		"depth":     depth,
@@ -380,6 +396,7 @@ func validationsCode(att *design.AttributeDefinition, data map[string]interface{
 			data["min"] = fmt.Sprintf("%f", *min)
 		}
 		data["isMin"] = true
+		data["exclusive"] = validation.ExclusiveMinimum
 		delete(data, "max")
 		if val := RunTemplate(minMaxValT, data); val != "" {
 			res = append(res, val)
@@ -392,6 +409,7 @@ func validationsCode(att *design.AttributeDefinition, data map[string]interface{
 			data["max"] = fmt.Sprintf("%f", *max)
 		}
 		data["isMin"] = false
+		data["exclusive"] = validation.ExclusiveMaximum
 		delete(data, "min")
 		if val := RunTemplate(minMaxValT, data); val != "" {
 			res = append(res, val)
@@ -413,6 +431,27 @@ func validationsCode(att *design.AttributeDefinition, data map[string]interface{
 			res = append(res, val)
 		}
 	}
+	if validation.UniqueItems && att.Type.IsArray() {
+		data["elemType"] = GoNativeType(att.Type.ToArray().ElemType.Type)
+		if val := RunTemplate(uniqueValT, data); val != "" {
+			res = append(res, val)
+		}
+	}
+	if key := validation.UniqueKey; key != "" && att.Type.IsArray() {
+		elem := att.Type.ToArray().ElemType
+		if ds, ok := elem.Type.(design.DataStructure); ok {
+			elem = ds.Definition()
+		}
+		if katt := elem.Type.ToObject()[key]; katt != nil {
+			data["key"] = key
+			data["keyType"] = GoNativeType(katt.Type)
+			data["keyField"] = "e." + GoifyAtt(katt, key, true)
+			data["keyPointer"] = data["private"].(bool) || elem.IsPrimitivePointer(key)
+			if val := RunTemplate(uniqueKeyT, data); val != "" {
+				res = append(res, val)
+			}
+		}
+	}
 	if required := validation.Required; len(required) > 0 {
 		var val string
 		for i, r := range required {
@@ -424,9 +463,100 @@ func validationsCode(att *design.AttributeDefinition, data map[string]interface{
 		}
 		res = append(res, val)
 	}
+	if groups := validation.ExactlyOneOf; len(groups) > 0 {
+		o := att.Type.ToObject()
+		var val []string
+		for _, names := range groups {
+			var checks []string
+			for _, n := range names {
+				if o[n] != nil {
+					checks = append(checks, fieldSetCheck(att, n, data["target"].(string), data["private"].(bool)))
+				}
+			}
+			if len(checks) == 0 {
+				continue
+			}
+			data["names"] = names
+			data["checks"] = checks
+			val = append(val, RunTemplate(oneOfValT, data))
+		}
+		if len(val) > 0 {
+			res = append(res, strings.Join(val, "\n"))
+		}
+	}
+	if conds := validation.RequiredIf; len(conds) > 0 {
+		o := att.Type.ToObject()
+		target, private := data["target"].(string), data["private"].(bool)
+		var val []string
+		for _, r := range conds {
+			catt := o[r.Attribute]
+			if catt == nil {
+				continue
+			}
+			field := fmt.Sprintf("%s.%s", target, GoifyAtt(catt, r.Attribute, true))
+			cond := fmt.Sprintf("%s == %#v", field, r.Value)
+			if fieldIsPointer(att, r.Attribute, private) {
+				cond = fmt.Sprintf("%s != nil && *%s == %#v", field, field, r.Value)
+			}
+			var missing []map[string]string
+			for _, n := range r.Required {
+				if o[n] == nil {
+					continue
+				}
+				if check := fieldUnsetCheck(att, n, target, private); check != "" {
+					missing = append(missing, map[string]string{"name": n, "check": check})
+				}
+			}
+			if len(missing) == 0 {
+				continue
+			}
+			data["condition"] = cond
+			data["conditionAttribute"] = r.Attribute
+			data["conditionValue"] = r.Value
+			data["missing"] = missing
+			val = append(val, RunTemplate(requiredIfT, data))
+		}
+		if len(val) > 0 {
+			res = append(res, strings.Join(val, "\n"))
+		}
+	}
 	return
 }
 
+// fieldIsPointer returns true if the field generated for the child attribute n of the object
+// attribute att is a pointer, a slice, a map or an interface.
+func fieldIsPointer(att *design.AttributeDefinition, n string, private bool) bool {
+	catt := att.Type.ToObject()[n]
+	return private || !catt.Type.IsPrimitive() || att.IsPrimitivePointer(n) || att.IsInterface(n)
+}
+
+// fieldSetCheck returns the Go expression that tests whether the field generated for the child
+// attribute n of the object attribute att is set or the empty string if the field is always set.
+func fieldSetCheck(att *design.AttributeDefinition, n, target string, private bool) string {
+	return fieldCheck(att, n, target, private, "!=")
+}
+
+// fieldUnsetCheck returns the Go expression that tests whether the field generated for the child
+// attribute n of the object attribute att is not set or the empty string if the field is always
+// set.
+func fieldUnsetCheck(att *design.AttributeDefinition, n, target string, private bool) string {
+	return fieldCheck(att, n, target, private, "==")
+}
+
+// fieldCheck compares the field generated for the child attribute n of the object attribute att
+// with its zero value using op.
+func fieldCheck(att *design.AttributeDefinition, n, target string, private bool, op string) string {
+	catt := att.Type.ToObject()[n]
+	field := fmt.Sprintf("%s.%s", target, GoifyAtt(catt, n, true))
+	if fieldIsPointer(att, n, private) {
+		return fmt.Sprintf("%s %s nil", field, op)
+	}
+	if catt.Type.Kind() == design.StringKind {
+		return fmt.Sprintf(`%s %s ""`, field, op)
+	}
+	return ""
+}
+
 // renderInteger renders a max or min value properly, taking into account
 // overflows due to casting from a float value.
 func renderInteger(f float64) string {
@@ -518,16 +648,16 @@ const (
 
 	minMaxValTmpl = `{{ $depth := or (and .isPointer (add .depth 1)) .depth }}{{/*
 */}}{{ if .isPointer }}{{ tabs .depth }}if {{ .target }} != nil {
-{{ end }}{{ tabs .depth }}	if {{ .targetVal }} {{ if .isMin }}<{{ else }}>{{ end }} {{ if .isMin }}{{ .min }}{{ else }}{{ .max }}{{ end }} {
-{{ tabs $depth }}	err = goa.MergeErrors(err, goa.InvalidRangeError(` + "`" + `{{ .context }}` + "`" + `, {{ .targetVal }}, {{ if .isMin }}{{ .min }}, true{{ else }}{{ .max }}, false{{ end }}))
+{{ end }}{{ tabs .depth }}	if {{ .targetVal }} {{ if .isMin }}<{{ else }}>{{ end }}{{ if .exclusive }}={{ end }} {{ if .isMin }}{{ .min }}{{ else }}{{ .max }}{{ end }} {
+{{ tabs $depth }}	err = goa.MergeErrors(err, goa.Invalid{{ if .exclusive }}Exclusive{{ end }}RangeError(` + "`" + `{{ .context }}` + "`" + `, {{ .targetVal }}, {{ if .isMin }}{{ .min }}, true{{ else }}{{ .max }}, false{{ end }}))
 {{ if .isPointer }}{{ tabs $depth }}}
 {{ end }}{{ tabs .depth }}}`
 
 	lengthValTmpl = `{{ $depth := or (and .isPointer (add .depth 1)) .depth }}{{/*
 */}}{{ $target := or (and (or (or .array .hash) .nonzero) .target) .targetVal }}{{/*
 */}}{{ if .isPointer }}{{ tabs .depth }}if {{ .target }} != nil {
-{{ end }}{{ tabs .depth }}	if {{ if .string }}utf8.RuneCountInString({{ $target }}){{ else }}len({{ $target }}){{ end }} {{ if .isMinLength }}<{{ else }}>{{ end }} {{ if .isMinLength }}{{ .minLength }}{{ else }}{{ .maxLength }}{{ end }} {
-{{ tabs $depth }}	err = goa.MergeErrors(err, goa.InvalidLengthError(` + "`" + `{{ .context }}` + "`" + `, {{ $target }}, {{ if .string }}utf8.RuneCountInString({{ $target }}){{ else }}len({{ $target }}){{ end }}, {{ if .isMinLength }}{{ .minLength }}, true{{ else }}{{ .maxLength }}, false{{ end }}))
+{{ end }}{{ tabs .depth }}	if {{ if .runes }}utf8.RuneCountInString({{ $target }}){{ else }}len({{ $target }}){{ end }} {{ if .isMinLength }}<{{ else }}>{{ end }} {{ if .isMinLength }}{{ .minLength }}{{ else }}{{ .maxLength }}{{ end }} {
+{{ tabs $depth }}	err = goa.MergeErrors(err, goa.InvalidLengthError(` + "`" + `{{ .context }}` + "`" + `, {{ $target }}, {{ if .runes }}utf8.RuneCountInString({{ $target }}){{ else }}len({{ $target }}){{ end }}, {{ if .isMinLength }}{{ .minLength }}, true{{ else }}{{ .maxLength }}, false{{ end }}))
 {{ if .isPointer }}{{ tabs $depth }}}
 {{ end }}{{ tabs .depth }}}`
 
@@ -537,4 +667,45 @@ const (
 {{ tabs $.depth }}}{{ else if or $.private (not $att.Type.IsPrimitive) }}{{ tabs $.depth }}if {{ $.target }}.{{ goifyAtt $att .required true }} == nil {
 {{ tabs $.depth }}	err = goa.MergeErrors(err, goa.MissingAttributeError(` + "`" + `{{ $.context }}` + "`" + `, "{{ .required }}"))
 {{ tabs $.depth }}}{{ end }}`
+
+	uniqueValTmpl = `{{ tabs .depth }}{
+{{ tabs .depth }}	seen := make(map[{{ .elemType }}]bool, len({{ .target }}))
+{{ tabs .depth }}	for _, e := range {{ .target }} {
+{{ tabs .depth }}		if seen[e] {
+{{ tabs .depth }}			err = goa.MergeErrors(err, goa.InvalidUniqueItemsError(` + "`" + `{{ .context }}` + "`" + `, e))
+{{ tabs .depth }}		}
+{{ tabs .depth }}		seen[e] = true
+{{ tabs .depth }}	}
+{{ tabs .depth }}}`
+
+	uniqueKeyTmpl = `{{ tabs .depth }}{
+{{ tabs .depth }}	seen := make(map[{{ .keyType }}]bool, len({{ .target }}))
+{{ tabs .depth }}	for _, e := range {{ .target }} {
+{{ tabs .depth }}		if e == nil{{ if .keyPointer }} || {{ .keyField }} == nil{{ end }} {
+{{ tabs .depth }}			continue
+{{ tabs .depth }}		}
+{{ tabs .depth }}		k := {{ if .keyPointer }}*{{ end }}{{ .keyField }}
+{{ tabs .depth }}		if seen[k] {
+{{ tabs .depth }}			err = goa.MergeErrors(err, goa.InvalidUniqueKeyError(` + "`" + `{{ .context }}` + "`" + `, "{{ .key }}", k))
+{{ tabs .depth }}		}
+{{ tabs .depth }}		seen[k] = true
+{{ tabs .depth }}	}
+{{ tabs .depth }}}`
+
+	oneOfValTmpl = `{{ tabs .depth }}{
+{{ tabs .depth }}	n := 0
+{{ range .checks }}{{ if . }}{{ tabs $.depth }}	if {{ . }} {
+{{ tabs $.depth }}		n++
+{{ tabs $.depth }}	}
+{{ else }}{{ tabs $.depth }}	n++
+{{ end }}{{ end }}{{ tabs .depth }}	if n != 1 {
+{{ tabs .depth }}		err = goa.MergeErrors(err, goa.InvalidExactlyOneOfError(` + "`" + `{{ .context }}` + "`" + `, {{ printf "%#v" .names }}, n))
+{{ tabs .depth }}	}
+{{ tabs .depth }}}`
+
+	requiredIfTmpl = `{{ tabs .depth }}if {{ .condition }} {
+{{ range .missing }}{{ tabs $.depth }}	if {{ .check }} {
+{{ tabs $.depth }}		err = goa.MergeErrors(err, goa.MissingConditionalAttributeError(` + "`" + `{{ $.context }}` + "`" + `, "{{ .name }}", "{{ $.conditionAttribute }}", {{ printf "%#v" $.conditionValue }}))
+{{ tabs $.depth }}	}
+{{ end }}{{ tabs .depth }}}`
 )
@@ -21,6 +21,7 @@ var _ = Describe("validation code generation", func() {
 			var att *design.AttributeDefinition
 			var attType design.DataType
 			var validation *dslengine.ValidationDefinition
+			var metadata dslengine.MetadataDefinition
 
 			target := "val"
 			context := "context"
@@ -30,9 +31,14 @@ var _ = Describe("validation code generation", func() {
 				att = new(design.AttributeDefinition)
 				att.Type = attType
 				att.Validation = validation
+				att.Metadata = metadata
 				code = codegen.NewValidator().Code(att, false, false, false, target, context, 1, false)
 			})
 
+			BeforeEach(func() {
+				metadata = nil
+			})
+
 			Context("of enum", func() {
 				BeforeEach(func() {
 					attType = design.Integer
@@ -73,6 +79,36 @@ var _ = Describe("validation code generation", func() {
 				})
 			})
 
+			Context("of exclusive min value 0", func() {
+				BeforeEach(func() {
+					attType = design.Integer
+					min := 0.0
+					validation = &dslengine.ValidationDefinition{
+						Minimum:          &min,
+						ExclusiveMinimum: true,
+					}
+				})
+
+				It("produces the validation go code", func() {
+					Ω(code).Should(Equal(exclusiveMinValCode))
+				})
+			})
+
+			Context("of exclusive max value 10", func() {
+				BeforeEach(func() {
+					attType = design.Integer
+					max := 10.0
+					validation = &dslengine.ValidationDefinition{
+						Maximum:          &max,
+						ExclusiveMaximum: true,
+					}
+				})
+
+				It("produces the validation go code", func() {
+					Ω(code).Should(Equal(exclusiveMaxValCode))
+				})
+			})
+
 			Context("of max value math.MaxInt64", func() {
 				BeforeEach(func() {
 					attType = design.Integer
@@ -119,6 +155,47 @@ var _ = Describe("validation code generation", func() {
 				})
 			})
 
+			Context("of array unique items", func() {
+				BeforeEach(func() {
+					attType = &design.Array{
+						ElemType: &design.AttributeDefinition{
+							Type: design.String,
+						},
+					}
+					validation = &dslengine.ValidationDefinition{
+						UniqueItems: true,
+					}
+				})
+
+				It("produces the validation go code", func() {
+					Ω(code).Should(Equal(arrayUniqueItemsValCode))
+				})
+			})
+
+			Context("of array unique key", func() {
+				BeforeEach(func() {
+					attType = &design.Array{
+						ElemType: &design.AttributeDefinition{
+							Type: &design.UserTypeDefinition{
+								TypeName: "Item",
+								AttributeDefinition: &design.AttributeDefinition{
+									Type: design.Object{
+										"sku": &design.AttributeDefinition{Type: design.String},
+									},
+								},
+							},
+						},
+					}
+					validation = &dslengine.ValidationDefinition{
+						UniqueKey: "sku",
+					}
+				})
+
+				It("produces the validation go code", func() {
+					Ω(code).Should(Equal(arrayUniqueKeyValCode))
+				})
+			})
+
 			Context("of array elements", func() {
 				BeforeEach(func() {
 					attType = &design.Array{
@@ -217,6 +294,51 @@ var _ = Describe("validation code generation", func() {
 				})
 			})
 
+			Context("of string min length 2 in bytes", func() {
+				BeforeEach(func() {
+					attType = design.String
+					min := 2
+					validation = &dslengine.ValidationDefinition{
+						MinLength: &min,
+					}
+					metadata = dslengine.MetadataDefinition{"length:unit": []string{"bytes"}}
+				})
+
+				It("produces the validation go code", func() {
+					Ω(code).Should(Equal(stringMinByteLengthValCode))
+				})
+			})
+
+			Context("of string min length 2 in runes", func() {
+				BeforeEach(func() {
+					attType = design.String
+					min := 2
+					validation = &dslengine.ValidationDefinition{
+						MinLength: &min,
+					}
+					metadata = dslengine.MetadataDefinition{"length:unit": []string{"runes"}}
+				})
+
+				It("produces the validation go code", func() {
+					Ω(code).Should(Equal(stringMinLengthValCode))
+				})
+			})
+
+			Context("of string max length 2 in bytes", func() {
+				BeforeEach(func() {
+					attType = design.String
+					max := 2
+					validation = &dslengine.ValidationDefinition{
+						MaxLength: &max,
+					}
+					metadata = dslengine.MetadataDefinition{"length:unit": []string{"bytes"}}
+				})
+
+				It("produces the validation go code", func() {
+					Ω(code).Should(Equal(stringMaxByteLengthValCode))
+				})
+			})
+
 			Context("of embedded object", func() {
 				var catt, ccatt *design.AttributeDefinition
 
@@ -281,6 +403,55 @@ var _ = Describe("validation code generation", func() {
 
 			})
 
+			Context("of exactly one of", func() {
+				BeforeEach(func() {
+					attType = design.Object{
+						"foo": &design.AttributeDefinition{Type: design.String},
+						"bar": &design.AttributeDefinition{Type: design.String},
+					}
+					validation = &dslengine.ValidationDefinition{
+						ExactlyOneOf: [][]string{{"foo", "bar"}},
+					}
+				})
+
+				It("produces the validation go code", func() {
+					Ω(code).Should(Equal(exactlyOneOfValCode))
+				})
+			})
+
+			Context("of exactly one of with no field in the object", func() {
+				BeforeEach(func() {
+					attType = design.Object{
+						"foo": &design.AttributeDefinition{Type: design.String},
+					}
+					validation = &dslengine.ValidationDefinition{
+						ExactlyOneOf: [][]string{{"bar", "baz"}},
+					}
+				})
+
+				It("produces no code", func() {
+					Ω(code).Should(BeEmpty())
+				})
+			})
+
+			Context("of required if", func() {
+				BeforeEach(func() {
+					attType = design.Object{
+						"channel": &design.AttributeDefinition{Type: design.String},
+						"phone":   &design.AttributeDefinition{Type: design.String},
+					}
+					validation = &dslengine.ValidationDefinition{
+						RequiredIf: []*dslengine.ConditionalRequiredDefinition{
+							{Attribute: "channel", Value: "sms", Required: []string{"phone"}},
+						},
+					}
+				})
+
+				It("produces the validation go code", func() {
+					Ω(code).Should(Equal(requiredIfValCode))
+				})
+			})
+
 			Context("of required user type attribute with no validation", func() {
 				var ut *design.UserTypeDefinition
 
@@ -378,6 +549,18 @@ const (
 		}
 	}`
 
+	exclusiveMinValCode = `	if val != nil {
+		if *val <= 0 {
+			err = goa.MergeErrors(err, goa.InvalidExclusiveRangeError(` + "`" + `context` + "`" + `, *val, 0, true))
+		}
+	}`
+
+	exclusiveMaxValCode = `	if val != nil {
+		if *val >= 10 {
+			err = goa.MergeErrors(err, goa.InvalidExclusiveRangeError(` + "`" + `context` + "`" + `, *val, 10, false))
+		}
+	}`
+
 	minminValCode = `	if val != nil {
 		if *val < -9223372036854775808 {
 			err = goa.MergeErrors(err, goa.InvalidRangeError(` + "`" + `context` + "`" + `, *val, -9223372036854775808, true))
@@ -390,6 +573,30 @@ const (
 		}
 	}`
 
+	arrayUniqueItemsValCode = `	{
+		seen := make(map[string]bool, len(val))
+		for _, e := range val {
+			if seen[e] {
+				err = goa.MergeErrors(err, goa.InvalidUniqueItemsError(` + "`" + `context` + "`" + `, e))
+			}
+			seen[e] = true
+		}
+	}`
+
+	arrayUniqueKeyValCode = `	{
+		seen := make(map[string]bool, len(val))
+		for _, e := range val {
+			if e == nil || e.Sku == nil {
+				continue
+			}
+			k := *e.Sku
+			if seen[k] {
+				err = goa.MergeErrors(err, goa.InvalidUniqueKeyError(` + "`" + `context` + "`" + `, "sku", k))
+			}
+			seen[k] = true
+		}
+	}`
+
 	arrayElementsValCode = `	for _, e := range val {
 		if ok := goa.ValidatePattern(` + "`" + `.*` + "`" + `, e); !ok {
 			err = goa.MergeErrors(err, goa.InvalidPatternError(` + "`" + `context[*]` + "`" + `, e, ` + "`" + `.*` + "`" + `))
@@ -423,6 +630,18 @@ const (
 		}
 	}`
 
+	stringMinByteLengthValCode = `	if val != nil {
+		if len(*val) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(` + "`" + `context` + "`" + `, *val, len(*val), 2, true))
+		}
+	}`
+
+	stringMaxByteLengthValCode = `	if val != nil {
+		if len(*val) > 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(` + "`" + `context` + "`" + `, *val, len(*val), 2, false))
+		}
+	}`
+
 	embeddedValCode = `	if val.Foo != nil {
 		if val.Foo.Bar != nil {
 			if !(*val.Foo.Bar == 1 || *val.Foo.Bar == 2 || *val.Foo.Bar == 3) {
@@ -458,6 +677,25 @@ const (
 		}
 	}`
 
+	exactlyOneOfValCode = `	{
+		n := 0
+		if val.Foo != nil {
+			n++
+		}
+		if val.Bar != nil {
+			n++
+		}
+		if n != 1 {
+			err = goa.MergeErrors(err, goa.InvalidExactlyOneOfError(` + "`context`" + `, []string{"foo", "bar"}, n))
+		}
+	}`
+
+	requiredIfValCode = `	if val.Channel != nil && *val.Channel == "sms" {
+		if val.Phone == nil {
+			err = goa.MergeErrors(err, goa.MissingConditionalAttributeError(` + "`context`" + `, "phone", "channel", "sms"))
+		}
+	}`
+
 	utCode = `	if val.Foo == nil {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(` + "`context`" + `, "foo"))
 	}`
@@ -637,6 +637,23 @@ var _ = Describe("ContextsWriter", func() {
 					Ω(written).Should(ContainSubstring(intArrayContextFactory))
 				})
 
+				Context("with a unique items validation", func() {
+					BeforeEach(func() {
+						arrayParam.Validation = &dslengine.ValidationDefinition{UniqueItems: true}
+					})
+
+					It("writes the array validation code", func() {
+						err := writer.Execute(data)
+						Ω(err).ShouldNot(HaveOccurred())
+						b, err := ioutil.ReadFile(filename)
+						Ω(err).ShouldNot(HaveOccurred())
+						written := string(b)
+						Ω(written).ShouldNot(BeEmpty())
+						Ω(written).Should(ContainSubstring(intArrayContext))
+						Ω(written).Should(ContainSubstring(intArrayUniqueContextFactory))
+					})
+				})
+
 				Context("with a default value", func() {
 					BeforeEach(func() {
 						arrayParam.SetDefault([]interface{}{1, 1, 2, 3, 5, 8})
@@ -2186,6 +2203,39 @@ func NewListBottleContext(ctx context.Context, r *http.Request, service *goa.Ser
 	}
 	return &rctx, err
 }
+`
+
+	intArrayUniqueContextFactory = `
+func NewListBottleContext(ctx context.Context, r *http.Request, service *goa.Service) (*ListBottleContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ListBottleContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramParam := req.Params["param"]
+	if len(paramParam) > 0 {
+		params := make([]int, len(paramParam))
+		for i, rawParam := range paramParam {
+			if param, err2 := strconv.Atoi(rawParam); err2 == nil {
+				params[i] = param
+			} else {
+				err = goa.MergeErrors(err, goa.InvalidParamTypeError("param", rawParam, "integer"))
+			}
+		}
+		rctx.Param = params
+		{
+			seen := make(map[int]bool, len(rctx.Param))
+			for _, e := range rctx.Param {
+				if seen[e] {
+					err = goa.MergeErrors(err, goa.InvalidUniqueItemsError(` + "`" + `param` + "`" + `, e))
+				}
+				seen[e] = true
+			}
+		}
+	}
+	return &rctx, err
+}
 `
 
 	intArrayDefaultContextFactory = `
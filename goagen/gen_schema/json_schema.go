@@ -39,11 +39,15 @@ type (
 		Format               string        `json:"format,omitempty"`
 		Pattern              string        `json:"pattern,omitempty"`
 		Minimum              *float64      `json:"minimum,omitempty"`
+		ExclusiveMinimum     bool          `json:"exclusiveMinimum,omitempty"`
 		Maximum              *float64      `json:"maximum,omitempty"`
+		ExclusiveMaximum     bool          `json:"exclusiveMaximum,omitempty"`
 		MinLength            *int          `json:"minLength,omitempty"`
 		MaxLength            *int          `json:"maxLength,omitempty"`
+		LengthUnit           string        `json:"x-length-unit,omitempty"`
 		MinItems             *int          `json:"minItems,omitempty"`
 		MaxItems             *int          `json:"maxItems,omitempty"`
+		UniqueItems          bool          `json:"uniqueItems,omitempty"`
 		Required             []string      `json:"required,omitempty"`
 		AdditionalProperties bool          `json:"additionalProperties,omitempty"`
 
@@ -330,6 +334,7 @@ func (s *JSONSchema) createMergeItems(other *JSONSchema) mergeItems {
 	maxInt := func(a, b *int) bool { return (a == nil && b != nil) || (a != nil && b != nil && *a < *b) }
 	minFloat := func(a, b *float64) bool { return (a == nil && b != nil) || (a != nil && b != nil && *a > *b) }
 	maxFloat := func(a, b *float64) bool { return (a == nil && b != nil) || (a != nil && b != nil && *a < *b) }
+	eqFloat := func(a, b *float64) bool { return a != nil && b != nil && *a == *b }
 	return mergeItems{
 		{&s.ID, other.ID, s.ID == ""},
 		{&s.Type, other.Type, s.Type == ""},
@@ -344,28 +349,38 @@ func (s *JSONSchema) createMergeItems(other *JSONSchema) mergeItems {
 		{&s.Format, other.Format, s.Format == ""},
 		{&s.Pattern, other.Pattern, s.Pattern == ""},
 		{&s.AdditionalProperties, other.AdditionalProperties, s.AdditionalProperties == false},
+		{&s.LengthUnit, other.LengthUnit, s.LengthUnit == ""},
+		{&s.UniqueItems, other.UniqueItems, s.UniqueItems == false},
 		{
-			a: s.Minimum, b: other.Minimum,
+			a: &s.Minimum, b: other.Minimum,
 			needed: minFloat(s.Minimum, other.Minimum),
 		},
 		{
-			a: s.Maximum, b: other.Maximum,
+			a: &s.ExclusiveMinimum, b: other.ExclusiveMinimum,
+			needed: minFloat(s.Minimum, other.Minimum) || (eqFloat(s.Minimum, other.Minimum) && !other.ExclusiveMinimum),
+		},
+		{
+			a: &s.Maximum, b: other.Maximum,
 			needed: maxFloat(s.Maximum, other.Maximum),
 		},
 		{
-			a: s.MinLength, b: other.MinLength,
+			a: &s.ExclusiveMaximum, b: other.ExclusiveMaximum,
+			needed: maxFloat(s.Maximum, other.Maximum) || (eqFloat(s.Maximum, other.Maximum) && !other.ExclusiveMaximum),
+		},
+		{
+			a: &s.MinLength, b: other.MinLength,
 			needed: minInt(s.MinLength, other.MinLength),
 		},
 		{
-			a: s.MaxLength, b: other.MaxLength,
+			a: &s.MaxLength, b: other.MaxLength,
 			needed: maxInt(s.MaxLength, other.MaxLength),
 		},
 		{
-			a: s.MinItems, b: other.MinItems,
+			a: &s.MinItems, b: other.MinItems,
 			needed: minInt(s.MinItems, other.MinItems),
 		},
 		{
-			a: s.MaxItems, b: other.MaxItems,
+			a: &s.MaxItems, b: other.MaxItems,
 			needed: maxInt(s.MaxItems, other.MaxItems),
 		},
 	}
@@ -422,11 +437,15 @@ func (s *JSONSchema) Dup() *JSONSchema {
 		Format:               s.Format,
 		Pattern:              s.Pattern,
 		Minimum:              s.Minimum,
+		ExclusiveMinimum:     s.ExclusiveMinimum,
 		Maximum:              s.Maximum,
+		ExclusiveMaximum:     s.ExclusiveMaximum,
 		MinLength:            s.MinLength,
 		MaxLength:            s.MaxLength,
+		LengthUnit:           s.LengthUnit,
 		MinItems:             s.MinItems,
 		MaxItems:             s.MaxItems,
+		UniqueItems:          s.UniqueItems,
 		Required:             s.Required,
 		AdditionalProperties: s.AdditionalProperties,
 	}
@@ -468,9 +487,11 @@ func buildAttributeSchema(api *design.APIDefinition, s *JSONSchema, at *design.A
 	s.Pattern = val.Pattern
 	if val.Minimum != nil {
 		s.Minimum = val.Minimum
+		s.ExclusiveMinimum = val.ExclusiveMinimum
 	}
 	if val.Maximum != nil {
 		s.Maximum = val.Maximum
+		s.ExclusiveMaximum = val.ExclusiveMaximum
 	}
 	if val.MinLength != nil {
 		switch {
@@ -488,6 +509,11 @@ func buildAttributeSchema(api *design.APIDefinition, s *JSONSchema, at *design.A
 			s.MaxLength = val.MaxLength
 		}
 	}
+	if (s.MinLength != nil || s.MaxLength != nil) && at.IsLengthInBytes() {
+		// JSON schema counts string lengths in characters.
+		s.LengthUnit = "bytes"
+	}
+	s.UniqueItems = val.UniqueItems
 	s.Required = val.Required
 	return s
 }
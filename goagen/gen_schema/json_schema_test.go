@@ -46,6 +46,71 @@ var _ = Describe("TypeSchema", func() {
 		})
 	})
 
+	Context("with an object with exclusive bounds", func() {
+		BeforeEach(func() {
+			min, max := 0.0, 1.0
+			typ = design.Object{
+				"ratio": &design.AttributeDefinition{
+					Type: design.Number,
+					Validation: &dslengine.ValidationDefinition{
+						Minimum:          &min,
+						ExclusiveMinimum: true,
+						Maximum:          &max,
+						ExclusiveMaximum: true,
+					},
+				},
+			}
+		})
+
+		It("sets the exclusive minimum and maximum fields", func() {
+			Ω(s).ShouldNot(BeNil())
+			Ω(s.Properties).Should(HaveKey("ratio"))
+			ratio := s.Properties["ratio"]
+			Ω(*ratio.Minimum).Should(Equal(0.0))
+			Ω(ratio.ExclusiveMinimum).Should(BeTrue())
+			Ω(*ratio.Maximum).Should(Equal(1.0))
+			Ω(ratio.ExclusiveMaximum).Should(BeTrue())
+		})
+	})
+
+	Context("with an object with a string length in bytes", func() {
+		BeforeEach(func() {
+			max := 3
+			typ = design.Object{
+				"name": &design.AttributeDefinition{
+					Type:       design.String,
+					Validation: &dslengine.ValidationDefinition{MaxLength: &max},
+					Metadata:   dslengine.MetadataDefinition{"length:unit": []string{"bytes"}},
+				},
+			}
+		})
+
+		It("flags the length unit", func() {
+			Ω(s).ShouldNot(BeNil())
+			Ω(s.Properties).Should(HaveKey("name"))
+			name := s.Properties["name"]
+			Ω(*name.MaxLength).Should(Equal(3))
+			Ω(name.LengthUnit).Should(Equal("bytes"))
+		})
+	})
+
+	Context("with an object with a unique items array", func() {
+		BeforeEach(func() {
+			typ = design.Object{
+				"tags": &design.AttributeDefinition{
+					Type:       &design.Array{ElemType: &design.AttributeDefinition{Type: design.String}},
+					Validation: &dslengine.ValidationDefinition{UniqueItems: true},
+				},
+			}
+		})
+
+		It("sets the unique items field", func() {
+			Ω(s).ShouldNot(BeNil())
+			Ω(s.Properties).Should(HaveKey("tags"))
+			Ω(s.Properties["tags"].UniqueItems).Should(BeTrue())
+		})
+	})
+
 	Context("with a media type with self-referencing attributes", func() {
 		BeforeEach(func() {
 			MediaType("application/vnd.menu+json", func() {
@@ -79,3 +144,76 @@ var _ = Describe("TypeSchema", func() {
 
 	})
 })
+
+var _ = Describe("Merge", func() {
+	var s, other *genschema.JSONSchema
+
+	JustBeforeEach(func() {
+		s.Merge(other)
+	})
+
+	Context("with equal bounds where only one is exclusive", func() {
+		BeforeEach(func() {
+			min, max, otherMin, otherMax := 0.0, 1.0, 0.0, 1.0
+			s = &genschema.JSONSchema{Minimum: &min, ExclusiveMinimum: true, Maximum: &max}
+			other = &genschema.JSONSchema{Minimum: &otherMin, Maximum: &otherMax, ExclusiveMaximum: true}
+		})
+
+		It("keeps the inclusive bounds", func() {
+			Ω(*s.Minimum).Should(Equal(0.0))
+			Ω(s.ExclusiveMinimum).Should(BeFalse())
+			Ω(*s.Maximum).Should(Equal(1.0))
+			Ω(s.ExclusiveMaximum).Should(BeFalse())
+		})
+	})
+
+	Context("with equal exclusive bounds", func() {
+		BeforeEach(func() {
+			min, otherMin := 0.0, 0.0
+			s = &genschema.JSONSchema{Minimum: &min, ExclusiveMinimum: true}
+			other = &genschema.JSONSchema{Minimum: &otherMin, ExclusiveMinimum: true}
+		})
+
+		It("keeps the exclusive bound", func() {
+			Ω(s.ExclusiveMinimum).Should(BeTrue())
+		})
+	})
+
+	Context("with a looser exclusive minimum", func() {
+		BeforeEach(func() {
+			min, otherMin := 1.0, 0.0
+			s = &genschema.JSONSchema{Minimum: &min}
+			other = &genschema.JSONSchema{Minimum: &otherMin, ExclusiveMinimum: true}
+		})
+
+		It("uses the other minimum and its exclusivity", func() {
+			Ω(*s.Minimum).Should(Equal(0.0))
+			Ω(s.ExclusiveMinimum).Should(BeTrue())
+		})
+	})
+
+	Context("with a looser exclusive maximum", func() {
+		BeforeEach(func() {
+			max, otherMax := 1.0, 2.0
+			s = &genschema.JSONSchema{Maximum: &max}
+			other = &genschema.JSONSchema{Maximum: &otherMax, ExclusiveMaximum: true}
+		})
+
+		It("uses the other maximum and its exclusivity", func() {
+			Ω(*s.Maximum).Should(Equal(2.0))
+			Ω(s.ExclusiveMaximum).Should(BeTrue())
+		})
+	})
+
+	Context("with a looser maximum length", func() {
+		BeforeEach(func() {
+			max, otherMax := 1, 2
+			s = &genschema.JSONSchema{MaxLength: &max}
+			other = &genschema.JSONSchema{MaxLength: &otherMax}
+		})
+
+		It("uses the other maximum length", func() {
+			Ω(*s.MaxLength).Should(Equal(2))
+		})
+	})
+})
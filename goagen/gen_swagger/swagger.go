@@ -1140,31 +1140,31 @@ func initPatternValidation(def interface{}, pattern string) {
 	}
 }
 
-func initMinimumValidation(def interface{}, min *float64) {
+func initMinimumValidation(def interface{}, min *float64, exclusive bool) {
 	switch actual := def.(type) {
 	case *Parameter:
 		actual.Minimum = min
-		actual.ExclusiveMinimum = false
+		actual.ExclusiveMinimum = exclusive
 	case *Header:
 		actual.Minimum = min
-		actual.ExclusiveMinimum = false
+		actual.ExclusiveMinimum = exclusive
 	case *Items:
 		actual.Minimum = min
-		actual.ExclusiveMinimum = false
+		actual.ExclusiveMinimum = exclusive
 	}
 }
 
-func initMaximumValidation(def interface{}, max *float64) {
+func initMaximumValidation(def interface{}, max *float64, exclusive bool) {
 	switch actual := def.(type) {
 	case *Parameter:
 		actual.Maximum = max
-		actual.ExclusiveMaximum = false
+		actual.ExclusiveMaximum = exclusive
 	case *Header:
 		actual.Maximum = max
-		actual.ExclusiveMaximum = false
+		actual.ExclusiveMaximum = exclusive
 	case *Items:
 		actual.Maximum = max
-		actual.ExclusiveMaximum = false
+		actual.ExclusiveMaximum = exclusive
 	}
 }
 
@@ -1198,6 +1198,28 @@ func initMaxLengthValidation(def interface{}, isArray bool, max *int) {
 	}
 }
 
+func initUniqueItemsValidation(def interface{}) {
+	switch actual := def.(type) {
+	case *Parameter:
+		actual.UniqueItems = true
+	case *Header:
+		actual.UniqueItems = true
+	case *Items:
+		actual.UniqueItems = true
+	}
+}
+
+// initLengthUnit records that the length validations of the parameter count bytes. Swagger
+// counts string lengths in characters so the unit is set as an extension.
+func initLengthUnit(def interface{}) {
+	if p, ok := def.(*Parameter); ok {
+		if p.Extensions == nil {
+			p.Extensions = make(map[string]interface{})
+		}
+		p.Extensions["x-length-unit"] = "bytes"
+	}
+}
+
 func initValidations(attr *design.AttributeDefinition, def interface{}) {
 	val := attr.Validation
 	if val == nil {
@@ -1207,10 +1229,10 @@ func initValidations(attr *design.AttributeDefinition, def interface{}) {
 	initFormatValidation(def, val.Format)
 	initPatternValidation(def, val.Pattern)
 	if val.Minimum != nil {
-		initMinimumValidation(def, val.Minimum)
+		initMinimumValidation(def, val.Minimum, val.ExclusiveMinimum)
 	}
 	if val.Maximum != nil {
-		initMaximumValidation(def, val.Maximum)
+		initMaximumValidation(def, val.Maximum, val.ExclusiveMaximum)
 	}
 	if val.MinLength != nil {
 		initMinLengthValidation(def, attr.Type.IsArray(), val.MinLength)
@@ -1218,4 +1240,10 @@ func initValidations(attr *design.AttributeDefinition, def interface{}) {
 	if val.MaxLength != nil {
 		initMaxLengthValidation(def, attr.Type.IsArray(), val.MaxLength)
 	}
+	if (val.MinLength != nil || val.MaxLength != nil) && attr.IsLengthInBytes() {
+		initLengthUnit(def)
+	}
+	if val.UniqueItems {
+		initUniqueItemsValidation(def)
+	}
 }
@@ -214,6 +214,104 @@ var _ = Describe("New", func() {
 			It("serializes into valid swagger JSON", func() { validateSwagger(swagger) })
 		})
 
+		Context("with base params with exclusive bounds", func() {
+			const (
+				basePath = "/i/:intParam/n/:numParam"
+				intParam = "intParam"
+				numParam = "numParam"
+				intMin   = 1.0
+				floatMax = 2.4
+			)
+
+			BeforeEach(func() {
+				base := Design.DSLFunc
+				Design.DSLFunc = func() {
+					base()
+					BasePath(basePath)
+					Params(func() {
+						Param(intParam, Integer, func() {
+							ExclusiveMinimum(intMin)
+						})
+						Param(numParam, Number, func() {
+							ExclusiveMaximum(floatMax)
+						})
+					})
+				}
+			})
+
+			It("sets the exclusive minimum and maximum fields", func() {
+				Ω(newErr).ShouldNot(HaveOccurred())
+				Ω(swagger.Parameters[intParam]).ShouldNot(BeNil())
+				Ω(*swagger.Parameters[intParam].Minimum).Should(Equal(intMin))
+				Ω(swagger.Parameters[intParam].ExclusiveMinimum).Should(BeTrue())
+				Ω(swagger.Parameters[intParam].ExclusiveMaximum).Should(BeFalse())
+				Ω(swagger.Parameters[numParam]).ShouldNot(BeNil())
+				Ω(*swagger.Parameters[numParam].Maximum).Should(Equal(floatMax))
+				Ω(swagger.Parameters[numParam].ExclusiveMaximum).Should(BeTrue())
+				Ω(swagger.Parameters[numParam].ExclusiveMinimum).Should(BeFalse())
+			})
+
+			It("serializes into valid swagger JSON", func() { validateSwagger(swagger) })
+		})
+
+		Context("with base params with unique items", func() {
+			const (
+				basePath = "/t/:tags"
+				tags     = "tags"
+			)
+
+			BeforeEach(func() {
+				base := Design.DSLFunc
+				Design.DSLFunc = func() {
+					base()
+					BasePath(basePath)
+					Params(func() {
+						Param(tags, ArrayOf(String), func() {
+							UniqueItems()
+						})
+					})
+				}
+			})
+
+			It("sets the unique items field", func() {
+				Ω(newErr).ShouldNot(HaveOccurred())
+				Ω(swagger.Parameters[tags]).ShouldNot(BeNil())
+				Ω(swagger.Parameters[tags].UniqueItems).Should(BeTrue())
+			})
+
+			It("serializes into valid swagger JSON", func() { validateSwagger(swagger) })
+		})
+
+		Context("with base params with a length counted in bytes", func() {
+			const (
+				basePath = "/n/:name"
+				name     = "name"
+			)
+
+			BeforeEach(func() {
+				base := Design.DSLFunc
+				Design.DSLFunc = func() {
+					base()
+					BasePath(basePath)
+					Params(func() {
+						Param(name, String, func() {
+							MaxLength(16)
+							Metadata("length:unit", "bytes")
+						})
+					})
+				}
+			})
+
+			It("sets the length unit extension", func() {
+				Ω(newErr).ShouldNot(HaveOccurred())
+				Ω(swagger.Parameters[name]).ShouldNot(BeNil())
+				Ω(*swagger.Parameters[name].MaxLength).Should(Equal(16))
+				Ω(swagger.Parameters[name].Extensions).Should(HaveKeyWithValue("x-length-unit", "bytes"))
+			})
+
+			It("serializes into valid swagger JSON", func() { validateSwagger(swagger) })
+		})
+
 		Context("with required payload", func() {
 			BeforeEach(func() {
 				p := Type("RequiredPayload", func() {
@@ -152,7 +152,9 @@ func (m *Generator) generateToolSourceCode(pkg *codegen.Package) {
 	imports := append(m.Imports,
 		codegen.SimpleImport("fmt"),
 		codegen.SimpleImport("strings"),
+		codegen.SimpleImport("github.com/goadesign/goa/design"),
 		codegen.SimpleImport("github.com/goadesign/goa/dslengine"),
+		codegen.SimpleImport("github.com/goadesign/goa/goagen/codegen"),
 		codegen.NewImport("_", filepath.ToSlash(m.DesignPkgPath)),
 	)
 	file.WriteHeader("Code Generator", "main", imports)
@@ -207,6 +209,9 @@ func main() {
 	// Now run the secondary DSLs
 	dslengine.FailOnError(dslengine.Run())
 
+	// Apply the API initialisms before generating any identifier
+	codegen.ConfigureInitialisms(design.Design)
+
 	files, err := {{.Genfunc}}()
 	dslengine.FailOnError(err)
 